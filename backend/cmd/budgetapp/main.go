@@ -0,0 +1,210 @@
+// Command budgetapp runs the budgetapp HTTP server.
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/alerts"
+	"halclarke97/budgetapp/backend/internal/api"
+	"halclarke97/budgetapp/backend/internal/attachments"
+	"halclarke97/budgetapp/backend/internal/banksync"
+	"halclarke97/budgetapp/backend/internal/config"
+	"halclarke97/budgetapp/backend/internal/digest"
+	"halclarke97/budgetapp/backend/internal/googlesheets"
+	"halclarke97/budgetapp/backend/internal/logging"
+	"halclarke97/budgetapp/backend/internal/notify"
+	"halclarke97/budgetapp/backend/internal/reportjob"
+	"halclarke97/budgetapp/backend/internal/retention"
+	"halclarke97/budgetapp/backend/internal/store"
+	"halclarke97/budgetapp/backend/internal/systemd"
+)
+
+// shutdownTimeout bounds how long we wait for in-flight requests to drain
+// before forcing the process down.
+const shutdownTimeout = 15 * time.Second
+
+// HTTP server timeouts. These bound how long a slow or stalled client
+// connection can tie up a handler goroutine or the store's write lock.
+const (
+	readHeaderTimeout = 5 * time.Second
+	readTimeout       = 15 * time.Second
+	writeTimeout      = 30 * time.Second
+	idleTimeout       = 120 * time.Second
+)
+
+func main() {
+	slog.SetDefault(logging.New())
+
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		slog.Error("load config", "error", err)
+		os.Exit(1)
+	}
+	if cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			slog.Error("load timezone", "timezone", cfg.Timezone, "error", err)
+			os.Exit(1)
+		}
+		time.Local = loc
+	}
+
+	// ENCRYPTION_KEY, if set, enables field-level encryption of notes and
+	// merchant names. It must be kept outside the data file itself, or a
+	// leaked file would carry its own decryption key.
+	var encryptionKey []byte
+	if raw := os.Getenv("ENCRYPTION_KEY"); raw != "" {
+		encryptionKey = []byte(raw)
+	}
+
+	st, err := store.New(cfg.DataFile, encryptionKey)
+	if err != nil {
+		slog.Error("open data file", "error", err)
+		os.Exit(1)
+	}
+	// The store persists every mutation synchronously in save(), so there's
+	// no batched write buffer to flush on shutdown.
+
+	stop := make(chan struct{})
+	var schedulers sync.WaitGroup
+	runScheduler := func(run func(*store.Store, time.Duration, <-chan struct{})) {
+		schedulers.Add(1)
+		go func() {
+			defer schedulers.Done()
+			run(st, cfg.SweepInterval, stop)
+		}()
+	}
+	runScheduler(digest.RunScheduler)
+	runScheduler(notify.RunBillReminders)
+	runScheduler(notify.RunQueueFlush)
+	runScheduler(alerts.RunSweep)
+	runScheduler(banksync.RunScheduler)
+	runScheduler(googlesheets.RunScheduler)
+	runScheduler(retention.RunScheduler)
+	runScheduler(func(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+		reportjob.RunScheduler(st, cfg.ReportsDir, interval, stop)
+	})
+	runScheduler(func(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+		attachments.RunGC(st, cfg.AttachmentsDir, interval, stop)
+	})
+
+	server := api.NewServer(st, api.Options{
+		CORSOrigins:              cfg.CORSOrigins,
+		AuthMode:                 cfg.AuthMode,
+		ReportsDir:               cfg.ReportsDir,
+		AttachmentsDir:           cfg.AttachmentsDir,
+		AttachmentScanClamAVAddr: cfg.AttachmentScanClamAVAddr,
+		SeparateAdminListener:    cfg.AdminBindAddress != "",
+	})
+
+	httpServer := &http.Server{
+		Handler:           server,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+
+	// adminServer, if cfg.AdminBindAddress is set, serves /api/admin/* and
+	// /debug/pprof/* on their own listener, unreachable from httpServer's.
+	var adminServer *http.Server
+	var adminListener net.Listener
+	if cfg.AdminBindAddress != "" {
+		adminServer = &http.Server{
+			Handler:           server.AdminHandler(),
+			ReadHeaderTimeout: readHeaderTimeout,
+			ReadTimeout:       readTimeout,
+			WriteTimeout:      writeTimeout,
+			IdleTimeout:       idleTimeout,
+		}
+		network, address := "tcp", cfg.AdminBindAddress
+		if strings.HasPrefix(address, "unix:") {
+			network, address = "unix", strings.TrimPrefix(address, "unix:")
+		}
+		adminListener, err = net.Listen(network, address)
+		if err != nil {
+			slog.Error("listen (admin)", "network", network, "address", address, "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Prefer a systemd-activated socket, if present, so budgetapp can run as
+	// a systemd .socket + .service pair without opening its own listener.
+	// Otherwise listen on a Unix domain socket if one was configured, or a
+	// TCP address by default.
+	listener, activated, err := systemd.Listener()
+	if err != nil {
+		slog.Error("systemd socket activation", "error", err)
+		os.Exit(1)
+	}
+	listenAddr := cfg.BindAddress + ":" + cfg.Port
+	if !activated {
+		network, address := "tcp", listenAddr
+		if cfg.SocketPath != "" {
+			network, address = "unix", cfg.SocketPath
+			if err := os.Remove(cfg.SocketPath); err != nil && !os.IsNotExist(err) {
+				slog.Error("remove stale socket", "path", cfg.SocketPath, "error", err)
+				os.Exit(1)
+			}
+		}
+		listener, err = net.Listen(network, address)
+		if err != nil {
+			slog.Error("listen", "network", network, "address", address, "error", err)
+			os.Exit(1)
+		}
+		listenAddr = address
+	} else {
+		listenAddr = "systemd-activated socket"
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		slog.Info("budgetapp listening", "addr", listenAddr, "data_file", cfg.DataFile)
+		serverErr <- httpServer.Serve(listener)
+	}()
+	if adminServer != nil {
+		go func() {
+			slog.Info("budgetapp admin listening", "addr", cfg.AdminBindAddress)
+			serverErr <- adminServer.Serve(adminListener)
+		}()
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case err := <-serverErr:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("server error", "error", err)
+			os.Exit(1)
+		}
+	case s := <-sig:
+		slog.Info("shutting down", "signal", s.String())
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		// Shutdown stops accepting new connections and waits for in-flight
+		// requests to finish, up to shutdownTimeout.
+		if err := httpServer.Shutdown(ctx); err != nil {
+			slog.Error("http server shutdown", "error", err)
+		}
+		if adminServer != nil {
+			if err := adminServer.Shutdown(ctx); err != nil {
+				slog.Error("admin http server shutdown", "error", err)
+			}
+		}
+	}
+
+	close(stop)
+	schedulers.Wait()
+	slog.Info("shutdown complete")
+}
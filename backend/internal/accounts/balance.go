@@ -0,0 +1,47 @@
+// Package accounts computes running balances for user-defined accounts
+// from their opening balance plus attributed income and expenses.
+package accounts
+
+import (
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Balance is an account's opening balance plus attributed income minus
+// attributed expenses.
+type Balance struct {
+	Account  *model.Account `json:"account"`
+	Income   money.Money    `json:"income"`
+	Expenses money.Money    `json:"expenses"`
+	Balance  money.Money    `json:"balance"`
+}
+
+// Compute returns the balance of every account in accountList, attributing
+// each expense/income entry to the account named by its AccountID.
+func Compute(accountList []*model.Account, expenses []*model.Expense, income []*model.Income) []Balance {
+	expenseTotals := make(map[string]money.Money)
+	for _, e := range expenses {
+		if e.AccountID != "" {
+			expenseTotals[e.AccountID] += e.Amount
+		}
+	}
+	incomeTotals := make(map[string]money.Money)
+	for _, inc := range income {
+		if inc.AccountID != "" {
+			incomeTotals[inc.AccountID] += inc.Amount
+		}
+	}
+
+	balances := make([]Balance, 0, len(accountList))
+	for _, a := range accountList {
+		exp := expenseTotals[a.ID]
+		inc := incomeTotals[a.ID]
+		balances = append(balances, Balance{
+			Account:  a,
+			Income:   inc,
+			Expenses: exp,
+			Balance:  a.OpeningBalance + inc - exp,
+		})
+	}
+	return balances
+}
@@ -0,0 +1,27 @@
+package accounts
+
+import (
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestComputeAddsOpeningBalancePlusIncomeMinusExpenses(t *testing.T) {
+	acct := &model.Account{ID: "a1", Name: "Checking", OpeningBalance: 1000}
+	expenses := []*model.Expense{
+		{AccountID: "a1", Amount: 50},
+		{AccountID: "a2", Amount: 999},
+	}
+	income := []*model.Income{
+		{AccountID: "a1", Amount: 200},
+	}
+
+	balances := Compute([]*model.Account{acct}, expenses, income)
+	if len(balances) != 1 {
+		t.Fatalf("got %d balances, want 1", len(balances))
+	}
+	b := balances[0]
+	if b.Income != 200 || b.Expenses != 50 || b.Balance != 1150 {
+		t.Errorf("unexpected balance: %+v", b)
+	}
+}
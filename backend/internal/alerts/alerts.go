@@ -0,0 +1,107 @@
+// Package alerts evaluates user-defined spending alert rules and delivers
+// notifications via the configured channels when they trigger.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/notify"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// CheckOnCreate evaluates every active rule against a newly recorded
+// expense: single_expense rules against e itself, and category_threshold
+// rules against e.Category's running total for the current period.
+func CheckOnCreate(ctx context.Context, st *store.Store, e *model.Expense) {
+	now := time.Now()
+	for _, rule := range st.ListAlertRules(false) {
+		switch rule.Kind {
+		case model.AlertSingleExpense:
+			if rule.Category != "" && rule.Category != e.Category {
+				continue
+			}
+			if e.Amount >= rule.Amount {
+				fire(ctx, st, rule, fmt.Sprintf("Expense of %s at %s exceeds your %s alert threshold", e.Amount, e.Merchant, rule.Amount))
+			}
+		case model.AlertCategoryThreshold:
+			if rule.Category != e.Category {
+				continue
+			}
+			checkCategoryThreshold(ctx, st, rule, now)
+		}
+	}
+}
+
+// Sweep re-checks every active category_threshold rule against current
+// totals, catching thresholds crossed by bulk imports or by expenses
+// recorded before the rule existed.
+func Sweep(ctx context.Context, st *store.Store, now time.Time) {
+	for _, rule := range st.ListAlertRules(false) {
+		if rule.Kind == model.AlertCategoryThreshold {
+			checkCategoryThreshold(ctx, st, rule, now)
+		}
+	}
+}
+
+func checkCategoryThreshold(ctx context.Context, st *store.Store, rule *model.AlertRule, now time.Time) {
+	s := st.Settings()
+	g := period.Granularity(rule.Period)
+	if rule.Period == "" {
+		g = period.Month
+	}
+	periodStart := period.Start(now, g, s)
+	if periodStart.Equal(rule.LastFiredPeriod) {
+		return
+	}
+	periodEnd := period.Next(periodStart, g)
+
+	threshold, ok := categoryThreshold(st, rule)
+	if !ok {
+		return
+	}
+
+	var total money.Money
+	for _, e := range st.List() {
+		if e.Category == rule.Category && !e.Date.Time().Before(periodStart) && e.Date.Time().Before(periodEnd) {
+			total += e.Amount
+		}
+	}
+	if total < threshold {
+		return
+	}
+	rule.LastFiredPeriod = periodStart
+	fire(ctx, st, rule, fmt.Sprintf("%s spending has reached %s this period (threshold %s)", rule.Category, total, threshold))
+}
+
+// categoryThreshold resolves the amount rule fires at: rule.Amount, unless
+// PercentOfBudget is set, in which case it's that percentage of the active
+// Budget.Limit for the same category. If PercentOfBudget is set but no
+// matching budget exists, ok is false and the rule can't be evaluated.
+func categoryThreshold(st *store.Store, rule *model.AlertRule) (threshold money.Money, ok bool) {
+	if rule.PercentOfBudget <= 0 {
+		return rule.Amount, true
+	}
+	for _, b := range st.ListBudgets(false) {
+		if b.Category == rule.Category {
+			return money.FromFloat(b.Limit.Float64() * rule.PercentOfBudget / 100), true
+		}
+	}
+	return 0, false
+}
+
+func fire(ctx context.Context, st *store.Store, rule *model.AlertRule, message string) {
+	if err := notify.Dispatch(ctx, st, fmt.Sprintf("Alert \"%s\": %s", rule.Name, message)); err != nil {
+		slog.Error("alerts: delivering alert failed", "rule", rule.Name, "error", err)
+	}
+	event := &model.AlertEvent{ID: idgen.New(), RuleID: rule.ID, Message: message, TriggeredAt: time.Now()}
+	if err := st.RecordAlertEvent(ctx, rule, event); err != nil {
+		slog.Error("alerts: recording event failed", "rule", rule.Name, "error", err)
+	}
+}
@@ -0,0 +1,75 @@
+package alerts
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+func newTestStore(t *testing.T) *store.Store {
+	t.Helper()
+	st, err := store.New(filepath.Join(t.TempDir(), "data.json"), nil)
+	if err != nil {
+		t.Fatalf("store.New: %v", err)
+	}
+	return st
+}
+
+func TestCheckOnCreateFiresSingleExpenseRule(t *testing.T) {
+	st := newTestStore(t)
+	rule := &model.AlertRule{ID: "r1", Name: "big spend", Kind: model.AlertSingleExpense, Amount: 500, Active: true, CreatedAt: time.Now()}
+	if err := st.AddAlertRule(context.Background(), rule); err != nil {
+		t.Fatalf("AddAlertRule: %v", err)
+	}
+
+	e := &model.Expense{ID: "e1", Amount: 600, Category: "Travel", Date: civildate.Today()}
+	CheckOnCreate(context.Background(), st, e)
+
+	events := st.ListAlertEvents()
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	if events[0].RuleID != "r1" {
+		t.Errorf("got rule id %q, want r1", events[0].RuleID)
+	}
+}
+
+func TestCheckOnCreateFiresCategoryThresholdOnceThenSuppresses(t *testing.T) {
+	st := newTestStore(t)
+	rule := &model.AlertRule{ID: "r2", Name: "entertainment cap", Kind: model.AlertCategoryThreshold, Category: "Entertainment", Amount: 100, Period: "month", Active: true, CreatedAt: time.Now()}
+	if err := st.AddAlertRule(context.Background(), rule); err != nil {
+		t.Fatalf("AddAlertRule: %v", err)
+	}
+
+	e1 := &model.Expense{ID: "e1", Amount: 80, Category: "Entertainment", Date: civildate.Today()}
+	if err := st.Add(context.Background(), e1); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	CheckOnCreate(context.Background(), st, e1)
+	if len(st.ListAlertEvents()) != 0 {
+		t.Fatalf("expected no events below threshold, got %d", len(st.ListAlertEvents()))
+	}
+
+	e2 := &model.Expense{ID: "e2", Amount: 30, Category: "Entertainment", Date: civildate.Today()}
+	if err := st.Add(context.Background(), e2); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	CheckOnCreate(context.Background(), st, e2)
+	if len(st.ListAlertEvents()) != 1 {
+		t.Fatalf("expected one event once threshold is crossed, got %d", len(st.ListAlertEvents()))
+	}
+
+	e3 := &model.Expense{ID: "e3", Amount: 10, Category: "Entertainment", Date: civildate.Today()}
+	if err := st.Add(context.Background(), e3); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	CheckOnCreate(context.Background(), st, e3)
+	if len(st.ListAlertEvents()) != 1 {
+		t.Errorf("expected rule to stay suppressed within the same period, got %d events", len(st.ListAlertEvents()))
+	}
+}
@@ -0,0 +1,23 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// RunSweep runs Sweep once per interval. It blocks until stop is closed, so
+// callers should run it in its own goroutine.
+func RunSweep(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			Sweep(context.Background(), st, now)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// meExport is the complete machine-readable archive of one user's data,
+// for GDPR-style data portability requests.
+type meExport struct {
+	User       *model.User               `json:"user"`
+	Expenses   []*model.Expense          `json:"expenses"`
+	Recurring  []*model.RecurringPattern `json:"recurring"`
+	APIKeys    []*model.APIKey           `json:"api_keys"`
+	ExportedAt time.Time                 `json:"exported_at"`
+}
+
+// handleExportMe returns everything stored under the authenticated user's
+// account as a single JSON archive.
+func (s *Server) handleExportMe(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	u, ok := s.store.GetUser(uid)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "user not found")
+		return
+	}
+	redacted := *u
+	redacted.PasswordHash = ""
+
+	var expenses []*model.Expense
+	for _, e := range s.store.List() {
+		if e.UserID == uid {
+			expenses = append(expenses, e)
+		}
+	}
+	var recurring []*model.RecurringPattern
+	for _, p := range s.store.ListRecurring(true) {
+		if p.UserID == uid {
+			recurring = append(recurring, p)
+		}
+	}
+	writeJSON(w, http.StatusOK, meExport{
+		User:       &redacted,
+		Expenses:   expenses,
+		Recurring:  recurring,
+		APIKeys:    redactKeys(s.store.ListAPIKeys(uid)),
+		ExportedAt: time.Now(),
+	})
+}
+
+// sessionInfo is the caller-facing view of an active session.
+type sessionInfo struct {
+	ID        string    `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// handleListSessions lists the authenticated user's active sessions, so
+// they can spot and revoke access from a device they no longer use.
+func (s *Server) handleListSessions(w http.ResponseWriter, r *http.Request) {
+	sessions := s.sessions.List(userID(r))
+	infos := make([]sessionInfo, len(sessions))
+	for i, sess := range sessions {
+		infos[i] = sessionInfo{
+			ID:        sess.ID,
+			UserAgent: sess.UserAgent,
+			CreatedAt: sess.CreatedAt,
+			LastSeen:  sess.LastSeen,
+		}
+	}
+	writeJSON(w, http.StatusOK, infos)
+}
+
+// handleRevokeSession signs out one of the authenticated user's sessions by
+// ID, e.g. after spotting an unrecognized device in handleListSessions.
+func (s *Server) handleRevokeSession(w http.ResponseWriter, r *http.Request) {
+	if !s.sessions.RevokeByID(userID(r), r.PathValue("id")) {
+		writeError(w, r, http.StatusNotFound, "session not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRequestErasure issues a short-lived confirmation token that must be
+// echoed back to DELETE /api/me, so a single forged or misclicked request
+// can't erase an account outright.
+func (s *Server) handleRequestErasure(w http.ResponseWriter, r *http.Request) {
+	token, err := s.erasureTokens.Issue(userID(r))
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to issue confirmation token")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"confirmation_token": token})
+}
+
+// handleEraseMe irreversibly deletes the authenticated user's account and
+// everything scoped to it, once confirmed with a token from
+// handleRequestErasure.
+func (s *Server) handleEraseMe(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "confirm") {
+		return
+	}
+	uid := userID(r)
+	token := r.URL.Query().Get("confirm")
+	if token == "" || !s.erasureTokens.Consume(uid, token) {
+		writeError(w, r, http.StatusBadRequest, "missing or invalid confirmation token; request one from POST /api/me/delete-confirmation")
+		return
+	}
+	if err := s.store.EraseUser(r.Context(), uid); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to erase account")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/accounts"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func (s *Server) handleListAccounts(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Account
+	for _, a := range s.store.ListAccounts(false) {
+		if a.UserID == uid {
+			owned = append(owned, a)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+type createAccountRequest struct {
+	Name           string            `json:"name"`
+	Type           model.AccountType `json:"type"`
+	OpeningBalance money.Money       `json:"opening_balance"`
+}
+
+func (s *Server) handleCreateAccount(w http.ResponseWriter, r *http.Request) {
+	var req createAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Name == "" {
+		writeError(w, r, http.StatusBadRequest, "name is required")
+		return
+	}
+	switch req.Type {
+	case model.AccountCash, model.AccountChecking, model.AccountSavings, model.AccountCreditCard:
+	default:
+		writeError(w, r, http.StatusBadRequest, "type must be \"cash\", \"checking\", \"savings\", or \"credit_card\"")
+		return
+	}
+	a := &model.Account{
+		ID:             idgen.New(),
+		Name:           req.Name,
+		Type:           req.Type,
+		OpeningBalance: req.OpeningBalance,
+		Active:         true,
+		CreatedAt:      time.Now(),
+		UserID:         userID(r),
+	}
+	if err := s.store.AddAccount(r.Context(), a); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save account")
+		return
+	}
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *Server) handleDeleteAccount(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	a, ok := s.store.GetAccount(id)
+	if !ok || a.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "account not found")
+		return
+	}
+	if err := s.store.DeleteAccount(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete account")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleAccountBalances(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var ownedAccounts []*model.Account
+	for _, a := range s.store.ListAccounts(false) {
+		if a.UserID == uid {
+			ownedAccounts = append(ownedAccounts, a)
+		}
+	}
+	var expenses []*model.Expense
+	for _, e := range s.store.List() {
+		if e.UserID == uid {
+			expenses = append(expenses, e)
+		}
+	}
+	var income []*model.Income
+	for _, inc := range s.store.ListIncome() {
+		if inc.UserID == uid {
+			income = append(income, inc)
+		}
+	}
+	balances := accounts.Compute(ownedAccounts, expenses, income)
+	writeJSON(w, http.StatusOK, balances)
+}
+
+type reconcileAccountRequest struct {
+	StatementBalance money.Money `json:"statement_balance"`
+	StatementDate    time.Time   `json:"statement_date"`
+}
+
+func (s *Server) handleReconcileAccount(w http.ResponseWriter, r *http.Request) {
+	var req reconcileAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.StatementDate.IsZero() {
+		writeError(w, r, http.StatusBadRequest, "statement_date is required")
+		return
+	}
+	id := r.PathValue("id")
+	a, ok := s.store.GetAccount(id)
+	if !ok || a.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "account not found")
+		return
+	}
+	rec, err := s.store.ReconcileAccount(r.Context(), id, req.StatementBalance, req.StatementDate)
+	if err != nil {
+		writeError(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) handleListReconciliations(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	a, ok := s.store.GetAccount(id)
+	if !ok || a.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "account not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.store.ListReconciliations(id))
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/retention"
+)
+
+// requireAdmin wraps h so it only runs when the caller presents the
+// operator's admin token via X-Admin-Token. If ADMIN_TOKEN isn't set, the
+// endpoint refuses every request rather than accepting an empty token.
+func requireAdmin(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := os.Getenv("ADMIN_TOKEN")
+		got := r.Header.Get("X-Admin-Token")
+		if token == "" || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			writeError(w, r, http.StatusUnauthorized, "admin token required")
+			return
+		}
+		h(w, r)
+	}
+}
+
+// debugStatus summarizes process and store health for diagnosing latency
+// spikes during large persists.
+type debugStatus struct {
+	Goroutines     int            `json:"goroutines"`
+	HeapAllocBytes uint64         `json:"heap_alloc_bytes"`
+	HeapObjects    uint64         `json:"heap_objects"`
+	NumGC          uint32         `json:"num_gc"`
+	RecordCounts   map[string]int `json:"record_counts"`
+	DataFileBytes  int64          `json:"data_file_bytes"`
+	StoreRevision  int64          `json:"store_revision"`
+	// PendingBatchWrites is how many expenses an in-flight bulk import or
+	// bank sync still has left to persist, i.e. queue depth during a burst.
+	PendingBatchWrites int64 `json:"pending_batch_writes"`
+}
+
+func (s *Server) handleAdminDebug(w http.ResponseWriter, r *http.Request) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	writeJSON(w, http.StatusOK, debugStatus{
+		Goroutines:         runtime.NumGoroutine(),
+		HeapAllocBytes:     mem.HeapAlloc,
+		HeapObjects:        mem.HeapObjects,
+		NumGC:              mem.NumGC,
+		RecordCounts:       s.store.RecordCounts(),
+		DataFileBytes:      s.store.DataFileSize(),
+		StoreRevision:      s.store.Revision(),
+		PendingBatchWrites: s.store.PendingBatchWrites(),
+	})
+}
+
+// storageStats reports on-disk and in-memory data file statistics, for
+// operators watching whether the JSON file is getting unwieldy.
+type storageStats struct {
+	DataFileBytes   int64          `json:"data_file_bytes"`
+	RecordCounts    map[string]int `json:"record_counts"`
+	StoreRevision   int64          `json:"store_revision"`
+	LastPersistedAt time.Time      `json:"last_persisted_at"`
+	// LastCompactedAt is always equal to LastPersistedAt: this store
+	// rewrites the entire data file on every persist (see
+	// internal/store.Store.save), so there's no separate compaction step
+	// or write-ahead log length to report.
+	LastCompactedAt time.Time `json:"last_compacted_at"`
+}
+
+func (s *Server) currentStorageStats() storageStats {
+	return storageStats{
+		DataFileBytes:   s.store.DataFileSize(),
+		RecordCounts:    s.store.RecordCounts(),
+		StoreRevision:   s.store.Revision(),
+		LastPersistedAt: s.store.LastPersistedAt(),
+		LastCompactedAt: s.store.LastPersistedAt(),
+	}
+}
+
+func (s *Server) handleAdminStorage(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.currentStorageStats())
+}
+
+// handleAdminCompact forces an immediate full rewrite of the data file and
+// returns the resulting stats.
+func (s *Server) handleAdminCompact(w http.ResponseWriter, r *http.Request) {
+	if err := s.store.Compact(r.Context()); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "compaction failed")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.currentStorageStats())
+}
+
+// handleAdminMerge accepts a multipart upload of another instance's data
+// file and folds its expenses into this store, for a user consolidating
+// data recorded on two devices. See Store.Merge for what is and isn't
+// merged.
+func (s *Server) handleAdminMerge(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(50 << 20); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "missing file")
+		return
+	}
+	defer file.Close()
+
+	result, err := s.store.Merge(r.Context(), file)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleAdminRetentionPurge runs the retention sweep (see internal/retention)
+// against the configured Settings.Retention cutoffs. ?dry_run=true counts
+// what would be removed without persisting anything, for previewing a
+// retention change before it takes effect.
+func (s *Server) handleAdminRetentionPurge(w http.ResponseWriter, r *http.Request) {
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	report, err := retention.Sweep(r.Context(), s.store, time.Now(), dryRun)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "retention purge failed")
+		return
+	}
+	writeJSON(w, http.StatusOK, report)
+}
+
+// registerDebugRoutes wires net/http/pprof's handlers onto our own mux
+// (pprof's init() only registers itself on http.DefaultServeMux, which we
+// don't use), gated behind the same admin token as /api/admin/debug.
+func (s *Server) registerDebugRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/admin/debug", requireAdmin(s.handleAdminDebug))
+	mux.HandleFunc("GET /api/admin/storage", requireAdmin(s.handleAdminStorage))
+	mux.HandleFunc("POST /api/admin/storage/compact", requireAdmin(s.handleAdminCompact))
+	mux.HandleFunc("POST /api/admin/storage/merge", requireAdmin(s.handleAdminMerge))
+	mux.HandleFunc("POST /api/admin/retention/purge", requireAdmin(s.handleAdminRetentionPurge))
+	mux.HandleFunc("GET /debug/pprof/", requireAdmin(pprof.Index))
+	mux.HandleFunc("GET /debug/pprof/cmdline", requireAdmin(pprof.Cmdline))
+	mux.HandleFunc("GET /debug/pprof/profile", requireAdmin(pprof.Profile))
+	mux.HandleFunc("GET /debug/pprof/symbol", requireAdmin(pprof.Symbol))
+	mux.HandleFunc("POST /debug/pprof/symbol", requireAdmin(pprof.Symbol))
+	mux.HandleFunc("GET /debug/pprof/trace", requireAdmin(pprof.Trace))
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func (s *Server) handleListAlertRules(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.store.ListAlertRules(false))
+}
+
+type createAlertRuleRequest struct {
+	Name            string          `json:"name"`
+	Kind            model.AlertKind `json:"kind"`
+	Category        string          `json:"category"`
+	Amount          money.Money     `json:"amount"`
+	PercentOfBudget float64         `json:"percent_of_budget"`
+	Period          string          `json:"period"`
+}
+
+func (s *Server) handleCreateAlertRule(w http.ResponseWriter, r *http.Request) {
+	var req createAlertRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Name == "" {
+		writeError(w, r, http.StatusBadRequest, "name is required")
+		return
+	}
+	if req.Amount <= 0 && req.PercentOfBudget <= 0 {
+		writeError(w, r, http.StatusBadRequest, "amount or percent_of_budget must be positive")
+		return
+	}
+	switch req.Kind {
+	case model.AlertCategoryThreshold:
+		if req.Category == "" {
+			writeError(w, r, http.StatusBadRequest, "category is required for category_threshold rules")
+			return
+		}
+		switch req.Period {
+		case "", "day", "week", "month":
+		default:
+			writeError(w, r, http.StatusBadRequest, "period must be \"day\", \"week\", or \"month\"")
+			return
+		}
+	case model.AlertSingleExpense:
+		if req.PercentOfBudget > 0 {
+			writeError(w, r, http.StatusBadRequest, "percent_of_budget only applies to category_threshold rules")
+			return
+		}
+	default:
+		writeError(w, r, http.StatusBadRequest, "kind must be \"category_threshold\" or \"single_expense\"")
+		return
+	}
+	rule := &model.AlertRule{
+		ID:              idgen.New(),
+		Name:            req.Name,
+		Kind:            req.Kind,
+		Category:        req.Category,
+		Amount:          req.Amount,
+		PercentOfBudget: req.PercentOfBudget,
+		Period:          req.Period,
+		Active:          true,
+		CreatedAt:       time.Now(),
+	}
+	if err := s.store.AddAlertRule(r.Context(), rule); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save alert rule")
+		return
+	}
+	writeJSON(w, http.StatusCreated, rule)
+}
+
+func (s *Server) handleDeleteAlertRule(w http.ResponseWriter, r *http.Request) {
+	if err := s.store.DeleteAlertRule(r.Context(), r.PathValue("id")); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete alert rule")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleListAlertHistory(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.store.ListAlertEvents())
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/attachments"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// maxAttachmentUploadBytes bounds a single upload, same limit as the OCR
+// receipt endpoint (see handleOCRReceipt).
+const maxAttachmentUploadBytes = 10 << 20
+
+// handleUploadAttachment saves a file against the expense at {id}, subject
+// to internal/attachments.MaxBytesPerUser and s.attachmentScan (file type
+// sniffing, a max image dimension, and an optional ClamAV pass), so a
+// shared household instance can't be used to distribute arbitrary files
+// under the guise of a receipt. Orphaned blobs (e.g. left behind if the
+// expense is later deleted) are reclaimed by internal/attachments.RunGC.
+func (s *Server) handleUploadAttachment(w http.ResponseWriter, r *http.Request) {
+	expenseID := r.PathValue("id")
+	e, ok := s.store.Get(expenseID)
+	if !ok || e.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "expense not found")
+		return
+	}
+	if s.attachmentsDir == "" {
+		writeError(w, r, http.StatusServiceUnavailable, "attachments are not configured")
+		return
+	}
+	if err := r.ParseMultipartForm(maxAttachmentUploadBytes); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "missing file")
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxAttachmentUploadBytes+1))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "failed to read file")
+		return
+	}
+	if len(data) > maxAttachmentUploadBytes {
+		writeError(w, r, http.StatusRequestEntityTooLarge, "file exceeds the upload limit")
+		return
+	}
+
+	uid := userID(r)
+	if s.store.AttachmentUsageBytes(uid)+int64(len(data)) > attachments.MaxBytesPerUser {
+		writeError(w, r, http.StatusInsufficientStorage, "attachment storage quota exceeded")
+		return
+	}
+
+	if s.attachmentScan != nil {
+		if err := s.attachmentScan.Scan(data, header.Filename); err != nil {
+			writeError(w, r, http.StatusUnprocessableEntity, "upload rejected: "+err.Error())
+			return
+		}
+	}
+
+	a := &model.Attachment{
+		ID:          idgen.New(),
+		ExpenseID:   expenseID,
+		UserID:      uid,
+		Filename:    header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Size:        int64(len(data)),
+		CreatedAt:   time.Now(),
+	}
+	if err := attachments.Save(s.attachmentsDir, a.ID, data); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save attachment")
+		return
+	}
+	if err := s.store.AddAttachment(r.Context(), a); err != nil {
+		_ = attachments.Delete(s.attachmentsDir, a.ID)
+		writeError(w, r, http.StatusInternalServerError, "failed to save attachment")
+		return
+	}
+	writeJSON(w, http.StatusCreated, a)
+}
+
+// handleListAttachments lists the caller's attachments on the expense at
+// {id}.
+func (s *Server) handleListAttachments(w http.ResponseWriter, r *http.Request) {
+	expenseID := r.PathValue("id")
+	e, ok := s.store.Get(expenseID)
+	if !ok || e.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "expense not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.store.ListAttachmentsForExpense(expenseID))
+}
+
+// handleDownloadAttachment streams back the raw bytes of an attachment the
+// caller owns.
+func (s *Server) handleDownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.store.GetAttachment(r.PathValue("id"))
+	if !ok || a.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "attachment not found")
+		return
+	}
+	data, err := attachments.Open(s.attachmentsDir, a.ID)
+	if err != nil {
+		writeError(w, r, http.StatusNotFound, "attachment blob not found")
+		return
+	}
+	if a.ContentType != "" {
+		w.Header().Set("Content-Type", a.ContentType)
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// handleDeleteAttachment removes an attachment the caller owns, both its
+// metadata record and its blob.
+func (s *Server) handleDeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.store.GetAttachment(r.PathValue("id"))
+	if !ok || a.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "attachment not found")
+		return
+	}
+	if err := s.store.DeleteAttachment(r.Context(), a.ID); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete attachment")
+		return
+	}
+	if err := attachments.Delete(s.attachmentsDir, a.ID); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete attachment blob")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// attachmentUsage reports the caller's attachment storage usage against
+// internal/attachments.MaxBytesPerUser.
+type attachmentUsage struct {
+	UsedBytes      int64 `json:"used_bytes"`
+	QuotaBytes     int64 `json:"quota_bytes"`
+	RemainingBytes int64 `json:"remaining_bytes"`
+}
+
+func (s *Server) handleAttachmentUsage(w http.ResponseWriter, r *http.Request) {
+	used := s.store.AttachmentUsageBytes(userID(r))
+	remaining := attachments.MaxBytesPerUser - used
+	if remaining < 0 {
+		remaining = 0
+	}
+	writeJSON(w, http.StatusOK, attachmentUsage{
+		UsedBytes:      used,
+		QuotaBytes:     attachments.MaxBytesPerUser,
+		RemainingBytes: remaining,
+	})
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/auth"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// sessionCookieName is the cookie carrying the session token issued at
+// login.
+const sessionCookieName = "budgetapp_session"
+
+// csrfCookieName is the cookie carrying the double-submit CSRF token issued
+// alongside the session cookie at login.
+const csrfCookieName = "budgetapp_csrf"
+
+type userIDKey struct{}
+
+func withUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// userID returns the authenticated user's ID for r. Every route other than
+// the auth endpoints requires a valid session, so this is only called after
+// that's already been checked.
+func userID(r *http.Request) string {
+	id, _ := r.Context().Value(userIDKey{}).(string)
+	return id
+}
+
+type registerRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+func (s *Server) handleRegister(w http.ResponseWriter, r *http.Request) {
+	var req registerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Email == "" || req.Password == "" {
+		writeError(w, r, http.StatusBadRequest, "email and password are required")
+		return
+	}
+	if len(req.Password) < 8 {
+		writeError(w, r, http.StatusBadRequest, "password must be at least 8 characters")
+		return
+	}
+	hash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to hash password")
+		return
+	}
+	u := &model.User{
+		ID:           idgen.New(),
+		Email:        req.Email,
+		PasswordHash: hash,
+		CreatedAt:    time.Now(),
+	}
+	if err := s.store.AddUser(r.Context(), u); err != nil {
+		writeError(w, r, http.StatusConflict, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"id": u.ID, "email": u.Email})
+}
+
+type loginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if until, locked := s.lockouts.Locked(req.Email); locked {
+		writeError(w, r, http.StatusTooManyRequests, "account temporarily locked, try again after "+until.Format(time.RFC3339))
+		return
+	}
+	u, ok := s.store.GetUserByEmail(req.Email)
+	if !ok || !auth.VerifyPassword(req.Password, u.PasswordHash) {
+		s.recordFailedLogin(r, req.Email)
+		writeError(w, r, http.StatusUnauthorized, "invalid email or password")
+		return
+	}
+	s.lockouts.Reset(req.Email)
+	_ = s.store.RecordAuditEvent(r.Context(), &model.AuditEvent{
+		ID:        idgen.New(),
+		Type:      model.AuditLoginSucceeded,
+		Email:     req.Email,
+		IP:        r.RemoteAddr,
+		CreatedAt: time.Now(),
+	})
+	token, err := s.sessions.Create(u.ID, r.UserAgent())
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to create session")
+		return
+	}
+	csrfToken, err := auth.NewToken()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to create session")
+		return
+	}
+	expires := time.Now().Add(30 * 24 * time.Hour)
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  expires,
+	})
+	http.SetCookie(w, &http.Cookie{
+		Name: csrfCookieName,
+		// Not HttpOnly: the frontend must be able to read it to echo it
+		// back as the X-CSRF-Token header on mutating requests.
+		Value:    csrfToken,
+		Path:     "/",
+		SameSite: http.SameSiteLaxMode,
+		Expires:  expires,
+	})
+	writeJSON(w, http.StatusOK, map[string]string{"id": u.ID, "email": u.Email})
+}
+
+// recordFailedLogin records a failed attempt against email, applying
+// exponential backoff once the threshold is reached, and appends an audit
+// event either way.
+func (s *Server) recordFailedLogin(r *http.Request, email string) {
+	lockout := s.lockouts.RecordFailure(email)
+	eventType := model.AuditLoginFailed
+	detail := "invalid credentials"
+	if lockout > 0 {
+		eventType = model.AuditAccountLocked
+		detail = "too many failed attempts, locked for " + lockout.String()
+	}
+	_ = s.store.RecordAuditEvent(r.Context(), &model.AuditEvent{
+		ID:        idgen.New(),
+		Type:      eventType,
+		Email:     email,
+		IP:        r.RemoteAddr,
+		Detail:    detail,
+		CreatedAt: time.Now(),
+	})
+}
+
+// handleListAuditEvents returns the audit log, most recent first, for
+// reviewing login activity on an internet-exposed instance.
+func (s *Server) handleListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.store.ListAuditEvents())
+}
+
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		s.sessions.Revoke(cookie.Value)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  time.Unix(0, 0),
+	})
+	http.SetCookie(w, &http.Cookie{
+		Name:     csrfCookieName,
+		Value:    "",
+		Path:     "/",
+		SameSite: http.SameSiteLaxMode,
+		Expires:  time.Unix(0, 0),
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/banksync"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func (s *Server) handleListLinkedAccounts(w http.ResponseWriter, r *http.Request) {
+	accounts := s.store.ListLinkedAccounts(false)
+	for _, a := range accounts {
+		a.AccessToken = ""
+	}
+	writeJSON(w, http.StatusOK, accounts)
+}
+
+type linkAccountRequest struct {
+	Institution       string `json:"institution"`
+	AccountName       string `json:"account_name"`
+	ExternalAccountID string `json:"external_account_id"`
+	AccessToken       string `json:"access_token"`
+}
+
+func (s *Server) handleLinkAccount(w http.ResponseWriter, r *http.Request) {
+	var req linkAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.AccessToken == "" {
+		writeError(w, r, http.StatusBadRequest, "access_token is required")
+		return
+	}
+	a := &model.LinkedAccount{
+		ID:                idgen.New(),
+		Institution:       req.Institution,
+		AccountName:       req.AccountName,
+		ExternalAccountID: req.ExternalAccountID,
+		AccessToken:       req.AccessToken,
+		Active:            true,
+		CreatedAt:         time.Now(),
+	}
+	if err := s.store.AddLinkedAccount(r.Context(), a); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save linked account")
+		return
+	}
+	a.AccessToken = ""
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *Server) handleUnlinkAccount(w http.ResponseWriter, r *http.Request) {
+	if err := s.store.DeleteLinkedAccount(r.Context(), r.PathValue("id")); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to unlink account")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleSyncAccount triggers an immediate sync of one linked account,
+// rather than waiting for the scheduled sync.
+func (s *Server) handleSyncAccount(w http.ResponseWriter, r *http.Request) {
+	account, ok := s.store.GetLinkedAccount(r.PathValue("id"))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "linked account not found")
+		return
+	}
+	sett := s.store.Settings()
+	c := banksync.NewClient(sett.PlaidBaseURL, sett.PlaidClientID, sett.PlaidSecret)
+	if err := banksync.SyncAccount(r.Context(), s.store, c, account); err != nil {
+		writeError(w, r, http.StatusBadGateway, "sync failed: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "synced"})
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/recurring"
+	"halclarke97/budgetapp/backend/internal/report"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// recentExpensesLimit bounds how many expenses handleBootstrap returns, so
+// the response stays small on accounts with years of history.
+const recentExpensesLimit = 10
+
+// bootstrapResponse bundles everything the frontend needs to render its
+// initial screen, so a cold start costs one request instead of five.
+type bootstrapResponse struct {
+	Categories        []categorySummary      `json:"categories"`
+	Settings          settings.Settings      `json:"settings"`
+	CurrentMonth      report.Monthly         `json:"current_month"`
+	RecentExpenses    []*model.Expense       `json:"recent_expenses"`
+	UpcomingRecurring []recurring.Occurrence `json:"upcoming_recurring"`
+}
+
+// handleBootstrap returns categories, settings, current-month stats, recent
+// expenses, and upcoming recurring occurrences in one response, so the
+// frontend's cold start doesn't cost five sequential round trips.
+func (s *Server) handleBootstrap(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	now := time.Now()
+
+	owned := filterExpenses(s.store.List(), func(e *model.Expense) bool { return e.UserID == uid })
+
+	recent := make([]*model.Expense, len(owned))
+	copy(recent, owned)
+	sort.Slice(recent, func(i, j int) bool { return recent[i].Date.After(recent[j].Date) })
+	if len(recent) > recentExpensesLimit {
+		recent = recent[:recentExpensesLimit]
+	}
+
+	var ownedPatterns []*model.RecurringPattern
+	for _, p := range s.store.ListRecurring(false) {
+		if p.UserID == uid {
+			ownedPatterns = append(ownedPatterns, p)
+		}
+	}
+
+	v := s.store.Settings()
+	v.ICSSecret = ""
+	v.SMTP.Password = ""
+	v.PlaidSecret = ""
+	v.GoogleSheets.RefreshToken = ""
+
+	totals := s.store.CategoryTotals()
+	categories := s.store.Categories()
+	categorySummaries := make([]categorySummary, 0, len(categories))
+	for _, c := range categories {
+		categorySummaries = append(categorySummaries, categorySummary{
+			ID:    c.ID,
+			Name:  c.Name,
+			Color: c.Color,
+			Icon:  c.Icon,
+			Total: totals[c.Name],
+		})
+	}
+
+	writeJSON(w, http.StatusOK, bootstrapResponse{
+		Categories:        categorySummaries,
+		Settings:          v,
+		CurrentMonth:      report.BuildMonthly(owned, now),
+		RecentExpenses:    recent,
+		UpcomingRecurring: recurring.Upcoming(ownedPatterns, now, now.AddDate(0, 0, 90)),
+	})
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/budgets"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func (s *Server) handleListBudgets(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Budget
+	for _, b := range s.store.ListBudgets(false) {
+		if b.UserID == uid {
+			owned = append(owned, b)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+type createBudgetRequest struct {
+	Category string      `json:"category"`
+	Limit    money.Money `json:"limit"`
+	Period   string      `json:"period"`
+}
+
+func (s *Server) handleCreateBudget(w http.ResponseWriter, r *http.Request) {
+	var req createBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Category == "" {
+		writeError(w, r, http.StatusBadRequest, "category is required")
+		return
+	}
+	if req.Limit <= 0 {
+		writeError(w, r, http.StatusBadRequest, "limit must be positive")
+		return
+	}
+	switch req.Period {
+	case "", "week", "month":
+	default:
+		writeError(w, r, http.StatusBadRequest, "period must be \"week\" or \"month\"")
+		return
+	}
+	b := &model.Budget{
+		ID:        idgen.New(),
+		Category:  req.Category,
+		Limit:     req.Limit,
+		Period:    req.Period,
+		Active:    true,
+		CreatedAt: time.Now(),
+		UserID:    userID(r),
+	}
+	if err := s.store.AddBudget(r.Context(), b); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save budget")
+		return
+	}
+	writeJSON(w, http.StatusCreated, b)
+}
+
+func (s *Server) handleDeleteBudget(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	b, ok := s.store.GetBudget(id)
+	if !ok || b.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "budget not found")
+		return
+	}
+	if err := s.store.DeleteBudget(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete budget")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleBudgetStatus(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Budget
+	for _, b := range s.store.ListBudgets(false) {
+		if b.UserID == uid {
+			owned = append(owned, b)
+		}
+	}
+	var expenses []*model.Expense
+	for _, e := range s.store.List() {
+		if e.UserID == uid {
+			expenses = append(expenses, e)
+		}
+	}
+	statuses := budgets.Compute(owned, expenses, s.store.Settings(), time.Now())
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+func (s *Server) handleSafeToSpend(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var expenses []*model.Expense
+	for _, e := range s.store.List() {
+		if e.UserID == uid {
+			expenses = append(expenses, e)
+		}
+	}
+	var recurring []*model.RecurringPattern
+	for _, p := range s.store.ListRecurring(false) {
+		if p.UserID == uid {
+			recurring = append(recurring, p)
+		}
+	}
+	sett := s.store.Settings()
+	result := budgets.ComputeSafeToSpend(sett.OverallMonthlyBudget, expenses, recurring, sett, time.Now())
+	writeJSON(w, http.StatusOK, result)
+}
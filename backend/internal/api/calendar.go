@@ -0,0 +1,92 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/recurring"
+)
+
+// calendarDay groups a single day's recorded expenses and projected
+// recurring occurrences, plus a running total of both combined.
+type calendarDay struct {
+	Date     string           `json:"date"`
+	Expenses []*model.Expense `json:"expenses"`
+	Upcoming []upcomingItem   `json:"upcoming"`
+	Total    money.Money      `json:"total"`
+}
+
+// handleCalendar returns a month's worth of days, each carrying the
+// expenses actually recorded on that day plus any recurring occurrences
+// projected to land on it, so the frontend can render a single month view
+// without three separate calls and client-side joining.
+func (s *Server) handleCalendar(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "month") {
+		return
+	}
+	monthParam := r.URL.Query().Get("month")
+	if monthParam == "" {
+		monthParam = time.Now().Format("2006-01")
+	}
+	month, err := time.Parse("2006-01", monthParam)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid month, expected YYYY-MM")
+		return
+	}
+	loc := s.store.Settings().Location()
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 1, 0)
+	uid := userID(r)
+
+	days := make(map[string]*calendarDay)
+	dayOf := func(t time.Time) *calendarDay {
+		key := t.In(loc).Format("2006-01-02")
+		d, ok := days[key]
+		if !ok {
+			d = &calendarDay{Date: key}
+			days[key] = d
+		}
+		return d
+	}
+
+	for _, e := range s.store.List() {
+		if e.UserID != uid || e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		d := dayOf(e.Date.Time())
+		d.Expenses = append(d.Expenses, e)
+		d.Total += e.Amount
+	}
+	var ownedRecurring []*model.RecurringPattern
+	for _, p := range s.store.ListRecurring(false) {
+		if p.UserID == uid {
+			ownedRecurring = append(ownedRecurring, p)
+		}
+	}
+	for _, occ := range recurring.Upcoming(ownedRecurring, from, to) {
+		d := dayOf(occ.Date)
+		d.Upcoming = append(d.Upcoming, upcomingItem{
+			Date:     occ.Date,
+			Category: occ.Pattern.Category,
+			Merchant: occ.Pattern.Merchant,
+			Amount:   occ.Pattern.Amount,
+			Note:     recurring.ExpandNote(occ.Pattern.Note, occ.Date),
+			Source:   "recurring",
+		})
+		d.Total += occ.Pattern.Amount
+	}
+
+	result := make([]*calendarDay, 0, len(days))
+	for _, d := range days {
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Date < result[j].Date })
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"month": from.Format("2006-01"),
+		"days":  result,
+	})
+}
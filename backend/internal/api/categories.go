@@ -0,0 +1,122 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// categorySummary pairs a category's display metadata with its all-time
+// spending total, so the frontend can render category chips and charts
+// without a second round trip to join colors onto totals itself.
+type categorySummary struct {
+	ID                   string      `json:"id"`
+	Name                 string      `json:"name"`
+	Color                string      `json:"color"`
+	Icon                 string      `json:"icon"`
+	Total                money.Money `json:"total"`
+	DefaultNote          string      `json:"default_note,omitempty"`
+	DefaultPaymentMethod string      `json:"default_payment_method,omitempty"`
+	DefaultTaxRate       float64     `json:"default_tax_rate,omitempty"`
+	RetentionDays        int         `json:"retention_days,omitempty"`
+}
+
+// handleListCategories returns display metadata (color, icon) plus the
+// all-time total for every category seen so far, auto-assigned from a
+// default palette the first time each name appeared on an expense or
+// budget.
+func (s *Server) handleListCategories(w http.ResponseWriter, r *http.Request) {
+	totals := s.store.CategoryTotals()
+	categories := s.store.Categories()
+	summaries := make([]categorySummary, 0, len(categories))
+	for _, c := range categories {
+		summaries = append(summaries, categorySummary{
+			ID:                   c.ID,
+			Name:                 c.Name,
+			Color:                c.Color,
+			Icon:                 c.Icon,
+			Total:                totals[c.Name],
+			DefaultNote:          c.DefaultNote,
+			DefaultPaymentMethod: c.DefaultPaymentMethod,
+			DefaultTaxRate:       c.DefaultTaxRate,
+			RetentionDays:        c.RetentionDays,
+		})
+	}
+	writeJSON(w, http.StatusOK, summaries)
+}
+
+type updateCategoryStyleRequest struct {
+	Color string `json:"color"`
+	Icon  string `json:"icon"`
+}
+
+// handleUpdateCategoryStyle lets a user override the auto-assigned color
+// and/or icon for a category, creating it with defaults first if it hasn't
+// been seen yet.
+func (s *Server) handleUpdateCategoryStyle(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	var req updateCategoryStyleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	c, err := s.store.UpdateCategoryStyle(r.Context(), name, req.Color, req.Icon)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save category")
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+type updateCategoryDefaultsRequest struct {
+	DefaultNote          string  `json:"default_note"`
+	DefaultPaymentMethod string  `json:"default_payment_method"`
+	DefaultTaxRate       float64 `json:"default_tax_rate"`
+}
+
+// handleUpdateCategoryDefaults sets the note, payment method, and/or tax
+// rate auto-applied to a new expense created in this category, creating it
+// with display defaults first if it hasn't been seen yet.
+func (s *Server) handleUpdateCategoryDefaults(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	var req updateCategoryDefaultsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	c, err := s.store.UpdateCategoryDefaults(r.Context(), name, req.DefaultNote, req.DefaultPaymentMethod, req.DefaultTaxRate)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save category")
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+type updateCategoryRetentionRequest struct {
+	RetentionDays int `json:"retention_days"`
+}
+
+// handleUpdateCategoryRetention sets the minimum age an expense in this
+// category must reach before internal/retention's expense purge can remove
+// it, e.g. a "business" category kept for 7 years versus a shorter default
+// for personal spending, creating the category with display defaults first
+// if it hasn't been seen yet.
+func (s *Server) handleUpdateCategoryRetention(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	var req updateCategoryRetentionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.RetentionDays < 0 {
+		writeError(w, r, http.StatusBadRequest, "retention_days must not be negative")
+		return
+	}
+	c, err := s.store.UpdateCategoryRetention(r.Context(), name, req.RetentionDays)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save category")
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
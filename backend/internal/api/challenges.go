@@ -0,0 +1,103 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/challenges"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func (s *Server) handleListChallenges(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Challenge
+	for _, c := range s.store.ListChallenges(false) {
+		if c.UserID == uid {
+			owned = append(owned, c)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+type createChallengeRequest struct {
+	Name         string              `json:"name"`
+	Type         model.ChallengeType `json:"type"`
+	Category     string              `json:"category"`
+	TargetAmount money.Money         `json:"target_amount"`
+	StartDate    time.Time           `json:"start_date"`
+	EndDate      time.Time           `json:"end_date"`
+}
+
+func (s *Server) handleCreateChallenge(w http.ResponseWriter, r *http.Request) {
+	var req createChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Name == "" {
+		writeError(w, r, http.StatusBadRequest, "name is required")
+		return
+	}
+	switch req.Type {
+	case model.ChallengeNoSpend, model.ChallengeSpendLimit:
+	default:
+		writeError(w, r, http.StatusBadRequest, "invalid type")
+		return
+	}
+	if req.Type == model.ChallengeSpendLimit && req.TargetAmount <= 0 {
+		writeError(w, r, http.StatusBadRequest, "target_amount must be positive")
+		return
+	}
+	if req.StartDate.IsZero() || req.EndDate.IsZero() || !req.EndDate.After(req.StartDate) {
+		writeError(w, r, http.StatusBadRequest, "end_date must be after start_date")
+		return
+	}
+	c := &model.Challenge{
+		ID:           idgen.New(),
+		Name:         req.Name,
+		Type:         req.Type,
+		Category:     req.Category,
+		TargetAmount: req.TargetAmount,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		Active:       true,
+		CreatedAt:    time.Now(),
+		UserID:       userID(r),
+	}
+	if err := s.store.AddChallenge(r.Context(), c); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save challenge")
+		return
+	}
+	writeJSON(w, http.StatusCreated, c)
+}
+
+func (s *Server) handleDeleteChallenge(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	c, ok := s.store.GetChallenge(id)
+	if !ok || c.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "challenge not found")
+		return
+	}
+	if err := s.store.DeleteChallenge(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete challenge")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleChallengeStatus reports every active challenge's automatically
+// evaluated progress against recorded expenses.
+func (s *Server) handleChallengeStatus(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var ownedChallenges []*model.Challenge
+	for _, c := range s.store.ListChallenges(false) {
+		if c.UserID == uid {
+			ownedChallenges = append(ownedChallenges, c)
+		}
+	}
+	statuses := challenges.Compute(ownedChallenges, ownedExpenses(s, r), time.Now())
+	writeJSON(w, http.StatusOK, statuses)
+}
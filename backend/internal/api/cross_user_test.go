@@ -0,0 +1,132 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// newTestServer builds a Server in "profile" auth mode, which uses the
+// X-Profile header as the data partition (see profileHeader) instead of
+// requiring a login flow, so tests can act as two distinct users just by
+// setting a different header per request.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	s, err := store.New(filepath.Join(t.TempDir(), "expenses.db"), nil)
+	if err != nil {
+		t.Fatalf("store.New: %v", err)
+	}
+	return NewServer(s, Options{AuthMode: "profile"})
+}
+
+func doRequest(t *testing.T, srv *Server, method, path, profile string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("marshal request body: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("X-Profile", profile)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	return rec
+}
+
+// TestExpensesAreScopedPerUser is a regression test for the class of bug
+// fixed across the synth-4403/4460/4476/4449/4368/4369/4435/4472/4446
+// series: an endpoint reading every user's data instead of just the
+// caller's. It creates one expense as "alice" and asserts "bob" can't see
+// it via any of the endpoints that were fixed to filter by userID(r).
+func TestExpensesAreScopedPerUser(t *testing.T) {
+	srv := newTestServer(t)
+
+	rec := doRequest(t, srv, "POST", "/api/expenses", "alice", createExpenseRequest{
+		Amount:   1000,
+		Category: "food",
+		Merchant: "alice-only-merchant",
+	})
+	if rec.Code != 201 {
+		t.Fatalf("create expense: status %d, body %s", rec.Code, rec.Body.String())
+	}
+
+	cases := []struct {
+		name string
+		path string
+	}{
+		{"list expenses", "/api/expenses"},
+		{"export ynab", "/api/export/ynab"},
+		{"stats trend", "/api/stats/trend"},
+		{"calendar", "/api/calendar"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec := doRequest(t, srv, "GET", c.path, "bob", nil)
+			if rec.Code != 200 {
+				t.Fatalf("status %d, body %s", rec.Code, rec.Body.String())
+			}
+			if bytes.Contains(rec.Body.Bytes(), []byte("alice-only-merchant")) {
+				t.Fatalf("bob's response leaked alice's expense: %s", rec.Body.String())
+			}
+		})
+	}
+
+	// alice herself should still see it.
+	rec = doRequest(t, srv, "GET", "/api/expenses", "alice", nil)
+	var got []*model.Expense
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(got) != 1 || got[0].Merchant != "alice-only-merchant" {
+		t.Fatalf("alice's own list = %+v, want her one expense", got)
+	}
+}
+
+// TestChallengeDeleteRejectsNonOwner is a regression test for the IDOR
+// fixed in handleDeleteChallenge: deleting another user's challenge by ID
+// must 404, not succeed.
+func TestChallengeDeleteRejectsNonOwner(t *testing.T) {
+	srv := newTestServer(t)
+
+	rec := doRequest(t, srv, "POST", "/api/challenges", "alice", createChallengeRequest{
+		Name:      "no-spend-march",
+		Type:      model.ChallengeNoSpend,
+		StartDate: time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if rec.Code != 201 {
+		t.Fatalf("create challenge: status %d, body %s", rec.Code, rec.Body.String())
+	}
+	var created model.Challenge
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	rec = doRequest(t, srv, "DELETE", "/api/challenges/"+created.ID, "bob", nil)
+	if rec.Code != 404 {
+		t.Fatalf("bob's delete of alice's challenge: status %d, want 404", rec.Code)
+	}
+
+	rec = doRequest(t, srv, "GET", "/api/challenges", "alice", nil)
+	var owned []*model.Challenge
+	if err := json.Unmarshal(rec.Body.Bytes(), &owned); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(owned) != 1 || owned[0].ID != created.ID {
+		t.Fatalf("alice's challenge should have survived bob's delete attempt, got %+v", owned)
+	}
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/debt"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func (s *Server) handleListDebts(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r) {
+		return
+	}
+	uid := userID(r)
+	var owned []*model.Debt
+	for _, d := range s.store.ListDebts(false) {
+		if d.UserID == uid {
+			owned = append(owned, d)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+type createDebtRequest struct {
+	Name           string      `json:"name"`
+	Balance        money.Money `json:"balance"`
+	APR            float64     `json:"apr"`
+	MinimumPayment money.Money `json:"minimum_payment"`
+}
+
+func (s *Server) handleCreateDebt(w http.ResponseWriter, r *http.Request) {
+	var req createDebtRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Name == "" {
+		writeError(w, r, http.StatusBadRequest, "name is required")
+		return
+	}
+	if req.Balance < 0 {
+		writeError(w, r, http.StatusBadRequest, "balance must not be negative")
+		return
+	}
+	d := &model.Debt{
+		ID:             idgen.New(),
+		Name:           req.Name,
+		Balance:        req.Balance,
+		APR:            req.APR,
+		MinimumPayment: req.MinimumPayment,
+		Active:         true,
+		CreatedAt:      time.Now(),
+		UserID:         userID(r),
+	}
+	if err := s.store.AddDebt(r.Context(), d); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save debt")
+		return
+	}
+	writeJSON(w, http.StatusCreated, d)
+}
+
+func (s *Server) handleDeleteDebt(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	d, ok := s.store.GetDebt(id)
+	if !ok || d.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "debt not found")
+		return
+	}
+	if err := s.store.DeleteDebt(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete debt")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleListDebtPayments(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r) {
+		return
+	}
+	id := r.PathValue("id")
+	d, ok := s.store.GetDebt(id)
+	if !ok || d.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "debt not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.store.ListDebtPayments(id))
+}
+
+func (s *Server) handleDebtPayoff(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "strategy", "extra") {
+		return
+	}
+	strategy := debt.Strategy(r.URL.Query().Get("strategy"))
+	if strategy == "" {
+		strategy = debt.Avalanche
+	}
+	var extra money.Money
+	if v := r.URL.Query().Get("extra"); v != "" {
+		parsed, err := money.Parse(v)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid extra amount")
+			return
+		}
+		extra = parsed
+	}
+	uid := userID(r)
+	var owned []*model.Debt
+	for _, d := range s.store.ListDebts(false) {
+		if d.UserID == uid {
+			owned = append(owned, d)
+		}
+	}
+	projections, err := debt.Project(owned, extra, strategy)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, projections)
+}
+
+// applyDebtPayment reduces the linked debt's balance when an expense is
+// tagged as a debt payment, so recording the payment as a normal recurring
+// expense also keeps the tracked balance current.
+func (s *Server) applyDebtPayment(ctx context.Context, e *model.Expense) {
+	if e.DebtID == "" {
+		return
+	}
+	payment := &model.DebtPayment{
+		ID:        idgen.New(),
+		DebtID:    e.DebtID,
+		ExpenseID: e.ID,
+		Amount:    e.Amount,
+		Date:      e.Date.Time(),
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.ApplyDebtPayment(ctx, payment); err != nil {
+		slog.Error("applying debt payment failed", "expense_id", e.ID, "error", err)
+	}
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/digest"
+	"halclarke97/budgetapp/backend/internal/mailer"
+)
+
+// handleSendTestDigest renders and sends a digest for the current period
+// immediately, without waiting for the scheduler or advancing
+// DigestLastSent, so users can verify their SMTP settings and preview the
+// content.
+func (s *Server) handleSendTestDigest(w http.ResponseWriter, r *http.Request) {
+	sett := s.store.Settings()
+	if sett.DigestRecipient == "" {
+		writeError(w, r, http.StatusBadRequest, "digest_recipient is not configured")
+		return
+	}
+	g := digest.Granularity(sett.DigestFrequency)
+	d := digest.Build(s.store.List(), s.store.ListRecurring(false), g, sett, time.Now())
+	subject, body := digest.Render(d)
+	if err := mailer.Send(sett.SMTP, sett.DigestRecipient, subject, body); err != nil {
+		writeError(w, r, http.StatusBadGateway, "failed to send digest: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "sent"})
+}
@@ -0,0 +1,351 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/alerts"
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/googlesheets"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/notify"
+	"halclarke97/budgetapp/backend/internal/stats"
+	"halclarke97/budgetapp/backend/internal/webhooks"
+)
+
+func (s *Server) handleListExpenses(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "account_id", "project_id", "classification") {
+		return
+	}
+	uid := userID(r)
+	list := filterExpenses(s.store.List(), func(e *model.Expense) bool { return e.UserID == uid })
+	if accountID := r.URL.Query().Get("account_id"); accountID != "" {
+		list = filterExpenses(list, func(e *model.Expense) bool { return e.AccountID == accountID })
+	}
+	if projectID := r.URL.Query().Get("project_id"); projectID != "" {
+		list = filterExpenses(list, func(e *model.Expense) bool { return e.ProjectID == projectID })
+	}
+	if classification := r.URL.Query().Get("classification"); classification != "" {
+		list = filterExpenses(list, func(e *model.Expense) bool {
+			c := e.Classification
+			if c == "" {
+				c = model.ClassificationPersonal
+			}
+			return string(c) == classification
+		})
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+// handleExpensesGeo returns the caller's expenses that have a recorded
+// location, for rendering on a map. ?bbox=minLon,minLat,maxLon,maxLat
+// restricts results to that bounding box; without it, every geotagged
+// expense is returned.
+func (s *Server) handleExpensesGeo(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "bbox") {
+		return
+	}
+	uid := userID(r)
+	list := filterExpenses(s.store.List(), func(e *model.Expense) bool {
+		return e.UserID == uid && (e.Latitude != 0 || e.Longitude != 0)
+	})
+
+	if bbox := r.URL.Query().Get("bbox"); bbox != "" {
+		minLon, minLat, maxLon, maxLat, err := parseBBox(bbox)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid bbox")
+			return
+		}
+		list = filterExpenses(list, func(e *model.Expense) bool {
+			return e.Longitude >= minLon && e.Longitude <= maxLon && e.Latitude >= minLat && e.Latitude <= maxLat
+		})
+	}
+
+	writeJSON(w, http.StatusOK, list)
+}
+
+// handleFrequentExpenses returns the amount/category/merchant combinations
+// the caller has logged most often in the trailing window (90 days by
+// default; ?from/?to override it), for a one-tap "log this again" list in
+// the UI.
+func (s *Server) handleFrequentExpenses(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "from", "to", "limit") {
+		return
+	}
+	from, to, ok := parseRange(r, 90)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	limit, err := intParam(r, "limit", 10)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid limit")
+		return
+	}
+	uid := userID(r)
+	owned := filterExpenses(s.store.List(), func(e *model.Expense) bool { return e.UserID == uid })
+	writeJSON(w, http.StatusOK, stats.FrequentCombos(owned, from, to, limit))
+}
+
+// parseBBox parses a "minLon,minLat,maxLon,maxLat" bounding box string.
+func parseBBox(s string) (minLon, minLat, maxLon, maxLat float64, err error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return 0, 0, 0, 0, fmt.Errorf("bbox must have 4 comma-separated values")
+	}
+	values := make([]float64, 4)
+	for i, p := range parts {
+		values[i], err = strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return 0, 0, 0, 0, err
+		}
+	}
+	return values[0], values[1], values[2], values[3], nil
+}
+
+func filterExpenses(expenses []*model.Expense, keep func(*model.Expense) bool) []*model.Expense {
+	filtered := make([]*model.Expense, 0, len(expenses))
+	for _, e := range expenses {
+		if keep(e) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+type createExpenseRequest struct {
+	Amount         money.Money          `json:"amount"`
+	Category       string               `json:"category"`
+	Merchant       string               `json:"merchant"`
+	Note           string               `json:"note"`
+	PaymentMethod  string               `json:"payment_method"`
+	Date           civildate.Date       `json:"date"`
+	AccountID      string               `json:"account_id"`
+	DebtID         string               `json:"debt_id"`
+	ProjectID      string               `json:"project_id"`
+	Deductible     bool                 `json:"deductible"`
+	TaxAmount      money.Money          `json:"tax_amount"`
+	TaxRate        float64              `json:"tax_rate"`
+	Classification model.Classification `json:"classification"`
+	Latitude       float64              `json:"latitude"`
+	Longitude      float64              `json:"longitude"`
+	PlaceName      string               `json:"place_name"`
+}
+
+func (s *Server) handleCreateExpense(w http.ResponseWriter, r *http.Request) {
+	var req createExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Category == "" {
+		writeError(w, r, http.StatusBadRequest, "category is required")
+		return
+	}
+	if req.Date.IsZero() {
+		req.Date = civildate.Today()
+	}
+	switch req.Classification {
+	case "", model.ClassificationPersonal, model.ClassificationBusiness:
+	default:
+		writeError(w, r, http.StatusBadRequest, "classification must be \"personal\" or \"business\"")
+		return
+	}
+	// Fill in whatever the category has a recorded default for and the
+	// request left blank, so routine entries don't need retyping the same
+	// note, payment method, or tax rate every time.
+	if c, ok := s.store.Category(req.Category); ok {
+		if req.Note == "" {
+			req.Note = c.DefaultNote
+		}
+		if req.PaymentMethod == "" {
+			req.PaymentMethod = c.DefaultPaymentMethod
+		}
+		if req.TaxRate == 0 {
+			req.TaxRate = c.DefaultTaxRate
+		}
+	}
+	e := &model.Expense{
+		ID:             idgen.New(),
+		Amount:         req.Amount,
+		Category:       req.Category,
+		Merchant:       req.Merchant,
+		Note:           req.Note,
+		PaymentMethod:  req.PaymentMethod,
+		Date:           req.Date,
+		CreatedAt:      time.Now(),
+		AccountID:      req.AccountID,
+		DebtID:         req.DebtID,
+		ProjectID:      req.ProjectID,
+		Deductible:     req.Deductible,
+		TaxAmount:      req.TaxAmount,
+		TaxRate:        req.TaxRate,
+		Classification: req.Classification,
+		UserID:         userID(r),
+		Latitude:       req.Latitude,
+		Longitude:      req.Longitude,
+		PlaceName:      req.PlaceName,
+	}
+	if err := s.store.Add(r.Context(), e); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save expense")
+		return
+	}
+	s.notifyLargeExpense(e)
+	go alerts.CheckOnCreate(context.Background(), s.store, e)
+	go func() {
+		if err := webhooks.DispatchEvent(context.Background(), s.store, model.WebhookEventExpenseCreated, e.UserID, e); err != nil {
+			slog.Error("webhook dispatch failed", "error", err)
+		}
+	}()
+	s.syncToGoogleSheets(e)
+	s.applyDebtPayment(r.Context(), e)
+	writeJSON(w, http.StatusCreated, e)
+}
+
+// cloneExpenseRequest overrides fields on the source expense when cloning
+// it. Every field is optional; an omitted or zero value keeps the source
+// expense's value, matching createExpenseRequest's treatment of zero
+// values as "not set".
+type cloneExpenseRequest struct {
+	Amount         money.Money          `json:"amount"`
+	Category       string               `json:"category"`
+	Merchant       string               `json:"merchant"`
+	Note           string               `json:"note"`
+	AccountID      string               `json:"account_id"`
+	ProjectID      string               `json:"project_id"`
+	Classification model.Classification `json:"classification"`
+}
+
+// handleCloneExpense duplicates an existing expense as a new one, for
+// "same as last time" entries without retyping every field. ?date sets the
+// new expense's date (YYYY-MM-DD, or "today"/omitted for today); an
+// optional JSON body overrides any other fields that should differ from
+// the source. DebtID isn't carried over: applying the same debt payment
+// twice would silently reduce the debt's balance a second time, so a
+// cloned debt payment starts as a plain expense the user can re-link.
+func (s *Server) handleCloneExpense(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "date") {
+		return
+	}
+	src, ok := s.store.Get(r.PathValue("id"))
+	if !ok || src.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "expense not found")
+		return
+	}
+
+	date := civildate.Today()
+	if raw := r.URL.Query().Get("date"); raw != "" && raw != "today" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "date must be in YYYY-MM-DD format")
+			return
+		}
+		date = civildate.FromTime(parsed)
+	}
+
+	var req cloneExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	e := &model.Expense{
+		ID:             idgen.New(),
+		Amount:         src.Amount,
+		Category:       src.Category,
+		Merchant:       src.Merchant,
+		Note:           src.Note,
+		PaymentMethod:  src.PaymentMethod,
+		Date:           date,
+		CreatedAt:      time.Now(),
+		AccountID:      src.AccountID,
+		ProjectID:      src.ProjectID,
+		Deductible:     src.Deductible,
+		TaxAmount:      src.TaxAmount,
+		TaxRate:        src.TaxRate,
+		Classification: src.Classification,
+		UserID:         userID(r),
+	}
+	if req.Amount != 0 {
+		e.Amount = req.Amount
+	}
+	if req.Category != "" {
+		e.Category = req.Category
+	}
+	if req.Merchant != "" {
+		e.Merchant = req.Merchant
+	}
+	if req.Note != "" {
+		e.Note = req.Note
+	}
+	if req.AccountID != "" {
+		e.AccountID = req.AccountID
+	}
+	if req.ProjectID != "" {
+		e.ProjectID = req.ProjectID
+	}
+	if req.Classification != "" {
+		switch req.Classification {
+		case model.ClassificationPersonal, model.ClassificationBusiness:
+			e.Classification = req.Classification
+		default:
+			writeError(w, r, http.StatusBadRequest, "classification must be \"personal\" or \"business\"")
+			return
+		}
+	}
+
+	if err := s.store.Add(r.Context(), e); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save expense")
+		return
+	}
+	s.notifyLargeExpense(e)
+	go alerts.CheckOnCreate(context.Background(), s.store, e)
+	go func() {
+		if err := webhooks.DispatchEvent(context.Background(), s.store, model.WebhookEventExpenseCreated, e.UserID, e); err != nil {
+			slog.Error("webhook dispatch failed", "error", err)
+		}
+	}()
+	s.syncToGoogleSheets(e)
+	writeJSON(w, http.StatusCreated, e)
+}
+
+// syncToGoogleSheets appends the expense to the configured Google Sheet
+// when SyncMode is "append". Delivery happens off the request goroutine
+// for the same reason as notifyLargeExpense: it depends on a third-party
+// API call that shouldn't add latency to expense creation.
+func (s *Server) syncToGoogleSheets(e *model.Expense) {
+	cfg := s.store.Settings().GoogleSheets
+	if cfg.SyncMode != "append" || cfg.ClientID == "" || cfg.RefreshToken == "" || cfg.SpreadsheetID == "" {
+		return
+	}
+	go func() {
+		if err := googlesheets.AppendExpense(cfg, e); err != nil {
+			slog.Error("google sheets append failed", "expense_id", e.ID, "error", err)
+		}
+	}()
+}
+
+// notifyLargeExpense fires the "large expense recorded" notification when
+// configured. Delivery happens off the request goroutine since it depends
+// on third-party webhooks that shouldn't add latency to expense creation.
+func (s *Server) notifyLargeExpense(e *model.Expense) {
+	threshold := s.store.Settings().LargeExpenseThreshold
+	if threshold <= 0 || e.Amount < threshold {
+		return
+	}
+	message := fmt.Sprintf("Large expense recorded: %s at %s (%s)", e.Amount, e.Merchant, e.Category)
+	go func() {
+		if err := notify.Dispatch(context.Background(), s.store, message); err != nil {
+			slog.Error("notify: large expense alert failed", "expense_id", e.ID, "error", err)
+		}
+	}()
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+
+	"halclarke97/budgetapp/backend/internal/export"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/stats"
+)
+
+// exportExpenses returns the expenses an export handler should render,
+// narrowed to a single classification when the classification query
+// parameter is set (e.g. "business", for a sole proprietor's accountant).
+func (s *Server) exportExpenses(r *http.Request) []*model.Expense {
+	uid := userID(r)
+	expenses := filterExpenses(s.store.List(), func(e *model.Expense) bool { return e.UserID == uid })
+	if c := r.URL.Query().Get("classification"); c != "" {
+		expenses = stats.FilterByClassification(expenses, model.Classification(c))
+	}
+	return expenses
+}
+
+func (s *Server) handleExportXLSX(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "classification") {
+		return
+	}
+	data, err := export.XLSX(s.exportExpenses(r))
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to generate export")
+		return
+	}
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", `attachment; filename="expenses.xlsx"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (s *Server) handleExportYNAB(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "classification") {
+		return
+	}
+	data, err := export.YNABRegister(s.exportExpenses(r))
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to generate export")
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="ynab-register.csv"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (s *Server) handleExportFirefly(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "classification") {
+		return
+	}
+	data, err := export.FireflyCSV(s.exportExpenses(r))
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to generate export")
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="firefly-import.csv"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
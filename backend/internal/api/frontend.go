@@ -0,0 +1,31 @@
+package api
+
+import (
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"halclarke97/budgetapp/backend/internal/webui"
+)
+
+// handleFrontend serves the embedded single-page app. Any path that doesn't
+// match a built asset falls back to index.html, so client-side routes (e.g.
+// /accounts, /settings) still work on a hard refresh or deep link.
+func (s *Server) handleFrontend(w http.ResponseWriter, r *http.Request) {
+	upath := strings.TrimPrefix(r.URL.Path, "/")
+	if upath == "" {
+		upath = "index.html"
+	}
+	if info, err := fs.Stat(webui.FS, upath); err != nil || info.IsDir() {
+		upath = "index.html"
+	}
+	if upath == "index.html" {
+		// Never cache the HTML shell so a new deploy is picked up on the
+		// next load; the hashed asset filenames it references are what
+		// actually get cached long-term.
+		w.Header().Set("Cache-Control", "no-cache")
+	} else {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	}
+	http.ServeFileFS(w, r, webui.FS, upath)
+}
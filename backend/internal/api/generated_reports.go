@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// generatedReport describes one file saved by the scheduled report job
+// (see internal/reportjob), for listing at GET /api/reports.
+type generatedReport struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// handleListGeneratedReports lists the PDF/CSV files the scheduled report
+// job has saved to the reports directory. An empty list (rather than an
+// error) is returned if the directory doesn't exist yet, since that just
+// means no report has been generated.
+func (s *Server) handleListGeneratedReports(w http.ResponseWriter, r *http.Request) {
+	if s.reportsDir == "" {
+		writeJSON(w, http.StatusOK, []generatedReport{})
+		return
+	}
+	entries, err := os.ReadDir(s.reportsDir)
+	if os.IsNotExist(err) {
+		writeJSON(w, http.StatusOK, []generatedReport{})
+		return
+	}
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to list reports")
+		return
+	}
+	reports := make([]generatedReport, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		reports = append(reports, generatedReport{Name: entry.Name(), Size: info.Size()})
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Name > reports[j].Name })
+	writeJSON(w, http.StatusOK, reports)
+}
+
+// handleDownloadGeneratedReport serves a single file previously listed by
+// handleListGeneratedReports. The requested name is resolved with
+// filepath.Base first, so a path like "../../etc/passwd" can't escape the
+// reports directory.
+func (s *Server) handleDownloadGeneratedReport(w http.ResponseWriter, r *http.Request) {
+	if s.reportsDir == "" {
+		writeError(w, r, http.StatusNotFound, "report not found")
+		return
+	}
+	name := filepath.Base(r.PathValue("name"))
+	path := filepath.Join(s.reportsDir, name)
+	if _, err := os.Stat(path); err != nil {
+		writeError(w, r, http.StatusNotFound, "report not found")
+		return
+	}
+	http.ServeFile(w, r, path)
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"net/http"
+
+	"halclarke97/budgetapp/backend/internal/googlesheets"
+)
+
+// handleSyncGoogleSheets triggers an immediate full resync, regardless of
+// the configured sync mode, so users can verify their OAuth credentials
+// and spreadsheet ID without waiting for the scheduler.
+func (s *Server) handleSyncGoogleSheets(w http.ResponseWriter, r *http.Request) {
+	cfg := s.store.Settings().GoogleSheets
+	if cfg.ClientID == "" || cfg.RefreshToken == "" || cfg.SpreadsheetID == "" {
+		writeError(w, r, http.StatusBadRequest, "google sheets sync is not configured")
+		return
+	}
+	if err := googlesheets.FullSync(cfg, s.store.List()); err != nil {
+		writeError(w, r, http.StatusBadGateway, "failed to sync: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "synced"})
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// readyStatus is the body returned by handleReadyz.
+type readyStatus struct {
+	Status          string    `json:"status"`
+	Revision        int64     `json:"revision,omitempty"`
+	LastPersistedAt time.Time `json:"last_persisted_at,omitempty"`
+}
+
+// handleHealthz is a liveness probe: it reports healthy as soon as the
+// process is up and serving HTTP, regardless of store state.
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleReadyz is a readiness probe: it additionally verifies the data file
+// is writable and reports the store's persistence state, so a load balancer
+// can hold back traffic until the store is actually usable.
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if s.store.Restoring() {
+		writeJSON(w, http.StatusServiceUnavailable, readyStatus{Status: "restoring"})
+		return
+	}
+	if err := s.store.CheckWritable(); err != nil {
+		writeJSON(w, http.StatusServiceUnavailable, readyStatus{Status: "data file not writable"})
+		return
+	}
+	writeJSON(w, http.StatusOK, readyStatus{
+		Status:          "ready",
+		Revision:        s.store.Revision(),
+		LastPersistedAt: s.store.LastPersistedAt(),
+	})
+}
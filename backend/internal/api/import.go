@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/importer"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+type importResult struct {
+	Rows    []importer.Row `json:"rows"`
+	Created int            `json:"created"`
+	Skipped int            `json:"skipped_duplicates"`
+	DryRun  bool           `json:"dry_run"`
+}
+
+// handleImportCSV accepts a multipart upload of a CSV file plus a "mapping"
+// field describing the columns, and either previews (dry_run=true) or
+// commits the parsed rows as expenses, skipping ones that look like
+// duplicates of existing data.
+func (s *Server) handleImportCSV(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "dry_run") {
+		return
+	}
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "missing file")
+		return
+	}
+	defer file.Close()
+
+	var mapping importer.ColumnMapping
+	if raw := r.FormValue("mapping"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid mapping JSON")
+			return
+		}
+	}
+
+	rows, err := importer.ParseCSV(file, mapping)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	writeJSON(w, http.StatusOK, s.commitImportRows(r.Context(), rows, dryRun))
+}
+
+// commitImportRows applies parsed rows against the store (unless dryRun),
+// skipping rows that look like duplicates of existing expenses. Rows that
+// pass the duplicate check are applied with a single AddBatch call rather
+// than one store write per row, so importing a large file rewrites the
+// data file once instead of once per row.
+func (s *Server) commitImportRows(ctx context.Context, rows []importer.Row, dryRun bool) importResult {
+	existing := s.store.List()
+	result := importResult{DryRun: dryRun}
+
+	var pending []importer.Row
+	var toAdd []*model.Expense
+	for _, row := range rows {
+		if row.Expense == nil {
+			result.Rows = append(result.Rows, row)
+			continue
+		}
+		if importer.IsDuplicate(existing, row.Expense) {
+			row.Error = "duplicate of an existing expense"
+			result.Rows = append(result.Rows, row)
+			result.Skipped++
+			continue
+		}
+		if !dryRun {
+			row.Expense.ID = idgen.New()
+			pending = append(pending, row)
+			toAdd = append(toAdd, row.Expense)
+			existing = append(existing, row.Expense)
+			continue
+		}
+		result.Created++
+		result.Rows = append(result.Rows, row)
+	}
+
+	if len(toAdd) == 0 {
+		return result
+	}
+	batch, err := s.store.AddBatch(ctx, toAdd)
+	for i, row := range pending {
+		if batchErr, skipped := batch.Skipped[i]; skipped {
+			row.Error = "failed to save: " + batchErr.Error()
+			result.Rows = append(result.Rows, row)
+			continue
+		}
+		if err != nil {
+			// The batch write itself failed (e.g. disk error); every row
+			// that wasn't already reported as skipped shares that fate.
+			row.Error = "failed to save"
+			result.Rows = append(result.Rows, row)
+			continue
+		}
+		result.Created++
+		result.Rows = append(result.Rows, row)
+	}
+	return result
+}
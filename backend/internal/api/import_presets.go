@@ -0,0 +1,30 @@
+package api
+
+import (
+	"io"
+	"net/http"
+
+	"halclarke97/budgetapp/backend/internal/importer"
+)
+
+func (s *Server) handleImportYNAB(w http.ResponseWriter, r *http.Request) {
+	s.handleImportPreset(w, r, importer.ParseYNAB)
+}
+
+func (s *Server) handleImportMint(w http.ResponseWriter, r *http.Request) {
+	s.handleImportPreset(w, r, importer.ParseMint)
+}
+
+func (s *Server) handleImportPreset(w http.ResponseWriter, r *http.Request, parse func(io.Reader) ([]importer.Row, error)) {
+	if !requireKnownQueryParams(w, r, "dry_run") {
+		return
+	}
+	defer r.Body.Close()
+	rows, err := parse(r.Body)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	writeJSON(w, http.StatusOK, s.commitImportRows(r.Context(), rows, dryRun))
+}
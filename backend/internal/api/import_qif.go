@@ -0,0 +1,23 @@
+package api
+
+import (
+	"net/http"
+
+	"halclarke97/budgetapp/backend/internal/importer"
+)
+
+// handleImportQIF accepts a raw QIF file body and imports its transactions,
+// splitting multi-category transactions into separate expenses.
+func (s *Server) handleImportQIF(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "dry_run") {
+		return
+	}
+	defer r.Body.Close()
+	rows, err := importer.ParseQIF(r.Body)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	writeJSON(w, http.StatusOK, s.commitImportRows(r.Context(), rows, dryRun))
+}
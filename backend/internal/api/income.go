@@ -0,0 +1,86 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func (s *Server) handleListIncome(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "account_id") {
+		return
+	}
+	uid := userID(r)
+	var list []*model.Income
+	for _, inc := range s.store.ListIncome() {
+		if inc.UserID == uid {
+			list = append(list, inc)
+		}
+	}
+	if accountID := r.URL.Query().Get("account_id"); accountID != "" {
+		filtered := make([]*model.Income, 0, len(list))
+		for _, inc := range list {
+			if inc.AccountID == accountID {
+				filtered = append(filtered, inc)
+			}
+		}
+		list = filtered
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+type createIncomeRequest struct {
+	Amount    money.Money `json:"amount"`
+	Source    string      `json:"source"`
+	Note      string      `json:"note"`
+	Date      time.Time   `json:"date"`
+	AccountID string      `json:"account_id"`
+}
+
+func (s *Server) handleCreateIncome(w http.ResponseWriter, r *http.Request) {
+	var req createIncomeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Source == "" {
+		writeError(w, r, http.StatusBadRequest, "source is required")
+		return
+	}
+	if req.Date.IsZero() {
+		req.Date = time.Now()
+	}
+	inc := &model.Income{
+		ID:        idgen.New(),
+		Amount:    req.Amount,
+		Source:    req.Source,
+		Note:      req.Note,
+		Date:      req.Date,
+		CreatedAt: time.Now(),
+		AccountID: req.AccountID,
+		UserID:    userID(r),
+	}
+	if err := s.store.AddIncome(r.Context(), inc); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save income")
+		return
+	}
+	writeJSON(w, http.StatusCreated, inc)
+}
+
+func (s *Server) handleDeleteIncome(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	inc, ok := s.store.GetIncome(id)
+	if !ok || inc.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "income not found")
+		return
+	}
+	if err := s.store.DeleteIncome(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete income")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
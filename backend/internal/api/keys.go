@@ -0,0 +1,83 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/auth"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func (s *Server) handleListKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, redactKeys(s.store.ListAPIKeys(userID(r))))
+}
+
+// redactKeys strips KeyHash before a key is ever sent back to a client; only
+// the raw key at creation time is meant to be seen outside the store.
+func redactKeys(keys []*model.APIKey) []*model.APIKey {
+	redacted := make([]*model.APIKey, len(keys))
+	for i, k := range keys {
+		clone := *k
+		clone.KeyHash = ""
+		redacted[i] = &clone
+	}
+	return redacted
+}
+
+type createKeyRequest struct {
+	Label string         `json:"label"`
+	Scope model.KeyScope `json:"scope"`
+}
+
+func (s *Server) handleCreateKey(w http.ResponseWriter, r *http.Request) {
+	var req createKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	switch req.Scope {
+	case model.KeyScopeReadOnly, model.KeyScopeReadWrite:
+	default:
+		writeError(w, r, http.StatusBadRequest, "scope must be \"read-only\" or \"read-write\"")
+		return
+	}
+	raw, hash, err := auth.NewAPIKey()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to generate key")
+		return
+	}
+	k := &model.APIKey{
+		ID:        idgen.New(),
+		UserID:    userID(r),
+		Label:     req.Label,
+		Scope:     req.Scope,
+		KeyHash:   hash,
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.AddAPIKey(r.Context(), k); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save key")
+		return
+	}
+	// The raw key is only ever available in this response.
+	resp := struct {
+		*model.APIKey
+		Key string `json:"key"`
+	}{APIKey: redactKeys([]*model.APIKey{k})[0], Key: raw}
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+func (s *Server) handleRevokeKey(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	k, ok := s.store.GetAPIKey(id)
+	if !ok || k.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "key not found")
+		return
+	}
+	if err := s.store.RevokeAPIKey(r.Context(), id, time.Now()); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to revoke key")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
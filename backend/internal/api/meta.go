@@ -0,0 +1,41 @@
+package api
+
+import "net/http"
+
+// currencySymbols maps the ISO 4217 codes budgetapp's settings UI offers to
+// their conventional symbol. Codes not listed here fall back to the code
+// itself (e.g. "CHF").
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"CAD": "$",
+	"AUD": "$",
+	"INR": "₹",
+}
+
+type metaResponse struct {
+	Currency       string `json:"currency"`
+	CurrencySymbol string `json:"currency_symbol"`
+	// DecimalPlaces is always 2: money.Money stores every amount as
+	// integer cents regardless of the configured currency.
+	DecimalPlaces int    `json:"decimal_places"`
+	Locale        string `json:"locale"`
+}
+
+// handleMeta exposes the currency and locale the frontend should use to
+// format amounts, so it doesn't have to hard-code a "$" prefix.
+func (s *Server) handleMeta(w http.ResponseWriter, r *http.Request) {
+	cfg := s.store.Settings()
+	symbol, ok := currencySymbols[cfg.Currency]
+	if !ok {
+		symbol = cfg.Currency
+	}
+	writeJSON(w, http.StatusOK, metaResponse{
+		Currency:       cfg.Currency,
+		CurrencySymbol: symbol,
+		DecimalPlaces:  2,
+		Locale:         cfg.Locale,
+	})
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func (s *Server) handleListChannels(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.store.ListChannels(false))
+}
+
+type createChannelRequest struct {
+	Type       model.ChannelType `json:"type"`
+	WebhookURL string            `json:"webhook_url"`
+	BotToken   string            `json:"bot_token"`
+	ChatID     string            `json:"chat_id"`
+}
+
+func (s *Server) handleCreateChannel(w http.ResponseWriter, r *http.Request) {
+	var req createChannelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	switch req.Type {
+	case model.ChannelSlack, model.ChannelDiscord:
+		if req.WebhookURL == "" {
+			writeError(w, r, http.StatusBadRequest, "webhook_url is required")
+			return
+		}
+	case model.ChannelTelegram:
+		if req.BotToken == "" || req.ChatID == "" {
+			writeError(w, r, http.StatusBadRequest, "bot_token and chat_id are required")
+			return
+		}
+	default:
+		writeError(w, r, http.StatusBadRequest, "type must be \"slack\", \"discord\", or \"telegram\"")
+		return
+	}
+	c := &model.NotificationChannel{
+		ID:         idgen.New(),
+		Type:       req.Type,
+		WebhookURL: req.WebhookURL,
+		BotToken:   req.BotToken,
+		ChatID:     req.ChatID,
+		Active:     true,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.store.AddChannel(r.Context(), c); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save channel")
+		return
+	}
+	writeJSON(w, http.StatusCreated, c)
+}
+
+func (s *Server) handleDeleteChannel(w http.ResponseWriter, r *http.Request) {
+	if err := s.store.DeleteChannel(r.Context(), r.PathValue("id")); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete channel")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
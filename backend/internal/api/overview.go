@@ -0,0 +1,103 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/budgets"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/recurring"
+)
+
+// overviewWindowDays matches handleUpcoming's recurring/reminder horizon,
+// so the budget-exhaustion projections it adds line up with the same
+// lookahead instead of introducing a second, inconsistent window.
+const overviewWindowDays = 90
+
+// handleOverviewUpcoming is handleUpcoming plus a third source: budgets on
+// pace to run out before their period ends. Merging all three into one
+// chronologically sorted feed, discriminated by Source, lets the client
+// render a single "what's coming up" list instead of joining three
+// endpoints itself.
+//
+// It reads from a single Store.Snapshot() call rather than separate
+// List/ListBudgets/ListRecurring/ListReminders calls, so the three sources
+// it merges are all as of the same revision instead of each potentially
+// straddling a different concurrent write, and this (heavier than most)
+// read only contends with a writer's lock once instead of four times.
+//
+// Snapshot() is store-wide, so each of the four slices it returns is
+// filtered down to the caller's own records before anything is merged or
+// projected, the same as every other multi-user endpoint.
+func (s *Server) handleOverviewUpcoming(w http.ResponseWriter, r *http.Request) {
+	from := time.Now()
+	to := from.AddDate(0, 0, overviewWindowDays)
+	snap := s.store.Snapshot()
+	uid := userID(r)
+
+	var recurringOwned []*model.RecurringPattern
+	for _, p := range snap.Recurring {
+		if p.UserID == uid {
+			recurringOwned = append(recurringOwned, p)
+		}
+	}
+	var remindersOwned []*model.Reminder
+	for _, rem := range snap.Reminders {
+		if rem.UserID == uid {
+			remindersOwned = append(remindersOwned, rem)
+		}
+	}
+	var budgetsOwned []*model.Budget
+	for _, b := range snap.Budgets {
+		if b.UserID == uid {
+			budgetsOwned = append(budgetsOwned, b)
+		}
+	}
+	var expensesOwned []*model.Expense
+	for _, e := range snap.Expenses {
+		if e.UserID == uid {
+			expensesOwned = append(expensesOwned, e)
+		}
+	}
+
+	var items []upcomingItem
+	for _, occ := range recurring.Upcoming(recurringOwned, from, to) {
+		items = append(items, upcomingItem{
+			Date:     occ.Date,
+			Category: occ.Pattern.Category,
+			Merchant: occ.Pattern.Merchant,
+			Amount:   occ.Pattern.Amount,
+			Note:     recurring.ExpandNote(occ.Pattern.Note, occ.Date),
+			Source:   "recurring",
+		})
+	}
+	for _, rem := range remindersOwned {
+		if rem.DueDate.Before(from) || !rem.DueDate.Before(to) {
+			continue
+		}
+		items = append(items, upcomingItem{
+			Date:     rem.DueDate,
+			Category: rem.Category,
+			Merchant: rem.Merchant,
+			Amount:   rem.Amount,
+			Note:     rem.Note,
+			Source:   "reminder",
+		})
+	}
+	for _, st := range budgets.Compute(budgetsOwned, expensesOwned, snap.Settings, from) {
+		date, ok := budgets.ProjectedExhaustion(st, from)
+		if !ok || date.Before(from) || !date.Before(to) {
+			continue
+		}
+		items = append(items, upcomingItem{
+			Date:     date,
+			Category: st.Budget.Category,
+			Amount:   st.Remaining,
+			Note:     "budget projected to run out",
+			Source:   "budget_exhaustion",
+		})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Date.Before(items[j].Date) })
+	writeJSON(w, http.StatusOK, items)
+}
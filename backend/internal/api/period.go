@@ -0,0 +1,134 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/report"
+)
+
+// handleGetPeriodLock reports the current closing cutoff, if any.
+func (s *Server) handleGetPeriodLock(w http.ResponseWriter, r *http.Request) {
+	cutoff := s.store.PeriodLockedBefore()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"locked":        !cutoff.IsZero(),
+		"locked_before": cutoff,
+	})
+}
+
+type closePeriodRequest struct {
+	Before time.Time `json:"before"`
+}
+
+// handleClosePeriod locks all expenses dated before req.Before against
+// creation, edits, and deletion, so a month already used for a tax filing
+// can't drift. The action is recorded as an audit event.
+func (s *Server) handleClosePeriod(w http.ResponseWriter, r *http.Request) {
+	var req closePeriodRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Before.IsZero() {
+		writeError(w, r, http.StatusBadRequest, "before is required")
+		return
+	}
+	if err := s.store.ClosePeriod(r.Context(), req.Before); err != nil {
+		writeError(w, r, http.StatusConflict, err.Error())
+		return
+	}
+	_ = s.store.RecordAuditEvent(r.Context(), &model.AuditEvent{
+		ID:        idgen.New(),
+		Type:      model.AuditPeriodClosed,
+		Detail:    "closed period before " + req.Before.Format("2006-01-02"),
+		CreatedAt: time.Now(),
+	})
+	writeJSON(w, http.StatusOK, map[string]any{"locked_before": req.Before})
+}
+
+// handleReopenPeriod clears a closing cutoff set by handleClosePeriod,
+// recording the reversal as an audit event since it re-exposes previously
+// locked expenses to edits.
+func (s *Server) handleReopenPeriod(w http.ResponseWriter, r *http.Request) {
+	if err := s.store.ReopenPeriod(r.Context()); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to reopen period")
+		return
+	}
+	_ = s.store.RecordAuditEvent(r.Context(), &model.AuditEvent{
+		ID:        idgen.New(),
+		Type:      model.AuditPeriodReopened,
+		CreatedAt: time.Now(),
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type closeoutRequest struct {
+	Notes string `json:"notes"`
+}
+
+// handleCloseoutMonth snapshots {month}'s totals and records req.Notes as
+// a journal entry, building a reviewable history of how each month went
+// independent of the separate period-lock feature (ClosePeriod), which
+// only protects expenses from further edits. Closing an already-closed
+// month replaces its entry with a fresh snapshot and the new notes.
+func (s *Server) handleCloseoutMonth(w http.ResponseWriter, r *http.Request) {
+	month, err := time.Parse("2006-01", r.PathValue("month"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "month must be in YYYY-MM format")
+		return
+	}
+	var req closeoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	uid := userID(r)
+	expenses := filterExpenses(s.store.List(), func(e *model.Expense) bool { return e.UserID == uid })
+	m := report.BuildMonthly(expenses, month)
+	categoryTotals := make([]model.PeriodCloseoutCategoryTotal, 0, len(m.CategoryTotals))
+	for _, ct := range m.CategoryTotals {
+		categoryTotals = append(categoryTotals, model.PeriodCloseoutCategoryTotal{Category: ct.Merchant, Total: ct.Total})
+	}
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	to := from.AddDate(0, 1, 0)
+	expenseCount := 0
+	for _, e := range expenses {
+		if !e.Date.Time().Before(from) && e.Date.Time().Before(to) {
+			expenseCount++
+		}
+	}
+
+	c := &model.PeriodCloseout{
+		ID:             idgen.New(),
+		Month:          m.Month,
+		Notes:          req.Notes,
+		Total:          m.Total,
+		ExpenseCount:   expenseCount,
+		CategoryTotals: categoryTotals,
+		CreatedAt:      time.Now(),
+		UserID:         uid,
+	}
+	if err := s.store.AddPeriodCloseout(r.Context(), c); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save close-out")
+		return
+	}
+	writeJSON(w, http.StatusCreated, c)
+}
+
+// handleListCloseouts returns the caller's recorded monthly close-outs,
+// most recent month first.
+func (s *Server) handleListCloseouts(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.PeriodCloseout
+	for _, c := range s.store.ListPeriodCloseouts() {
+		if c.UserID == uid {
+			owned = append(owned, c)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
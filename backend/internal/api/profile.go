@@ -0,0 +1,14 @@
+package api
+
+import "net/http"
+
+// handleActiveProfile reports which data partition the current request is
+// scoped to, so a frontend running in AuthMode "profile" can show which
+// profile it's talking to. In "session" or "none" mode, Active is instead
+// the authenticated user's ID (or empty).
+func (s *Server) handleActiveProfile(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"profile_mode": s.profileMode,
+		"active":       userID(r),
+	})
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func (s *Server) handleListProjects(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Project
+	for _, p := range s.store.ListProjects(false) {
+		if p.UserID == uid {
+			owned = append(owned, p)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+type createProjectRequest struct {
+	Name      string      `json:"name"`
+	Budget    money.Money `json:"budget"`
+	StartDate time.Time   `json:"start_date"`
+	EndDate   time.Time   `json:"end_date"`
+}
+
+func (s *Server) handleCreateProject(w http.ResponseWriter, r *http.Request) {
+	var req createProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Name == "" {
+		writeError(w, r, http.StatusBadRequest, "name is required")
+		return
+	}
+	if !req.StartDate.IsZero() && !req.EndDate.IsZero() && req.EndDate.Before(req.StartDate) {
+		writeError(w, r, http.StatusBadRequest, "end_date must not be before start_date")
+		return
+	}
+	p := &model.Project{
+		ID:        idgen.New(),
+		Name:      req.Name,
+		Budget:    req.Budget,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Active:    true,
+		CreatedAt: time.Now(),
+		UserID:    userID(r),
+	}
+	if err := s.store.AddProject(r.Context(), p); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save project")
+		return
+	}
+	writeJSON(w, http.StatusCreated, p)
+}
+
+type updateProjectRetentionRequest struct {
+	RetentionDays int `json:"retention_days"`
+}
+
+// handleUpdateProjectRetention sets the minimum age an expense linked to
+// this project must reach before internal/retention's expense purge can
+// remove it, e.g. a client project kept for 7 years for tax records.
+func (s *Server) handleUpdateProjectRetention(w http.ResponseWriter, r *http.Request) {
+	var req updateProjectRetentionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.RetentionDays < 0 {
+		writeError(w, r, http.StatusBadRequest, "retention_days must not be negative")
+		return
+	}
+	id := r.PathValue("id")
+	existing, ok := s.store.GetProject(id)
+	if !ok || existing.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "project not found")
+		return
+	}
+	p, err := s.store.UpdateProjectRetention(r.Context(), id, req.RetentionDays)
+	if err != nil {
+		writeError(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) handleDeleteProject(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	p, ok := s.store.GetProject(id)
+	if !ok || p.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "project not found")
+		return
+	}
+	if err := s.store.DeleteProject(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete project")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// projectSummary is a project's total spend, budget remaining, and expense
+// count, independent of category.
+type projectSummary struct {
+	Project      *model.Project `json:"project"`
+	Total        money.Money    `json:"total"`
+	ExpenseCount int            `json:"expense_count"`
+	// Remaining is Budget minus Total, omitted when the project has no
+	// budget set.
+	Remaining *money.Money `json:"remaining,omitempty"`
+}
+
+func (s *Server) handleProjectSummary(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	p, ok := s.store.GetProject(id)
+	if !ok || p.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "project not found")
+		return
+	}
+	summary := projectSummary{Project: p}
+	for _, e := range s.store.List() {
+		if e.ProjectID == id {
+			summary.Total += e.Amount
+			summary.ExpenseCount++
+		}
+	}
+	if p.Budget > 0 {
+		remaining := p.Budget - summary.Total
+		summary.Remaining = &remaining
+	}
+	writeJSON(w, http.StatusOK, summary)
+}
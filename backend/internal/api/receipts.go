@@ -0,0 +1,158 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/receipts"
+)
+
+type inboundEmailRequest struct {
+	From    string `json:"from"`
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+}
+
+// handleInboundReceipt accepts a forwarded receipt email from an inbound
+// email webhook (e.g. SendGrid's Inbound Parse, Mailgun Routes), parses it,
+// and stores it as a draft expense awaiting confirmation.
+func (s *Server) handleInboundReceipt(w http.ResponseWriter, r *http.Request) {
+	var req inboundEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.From == "" {
+		writeError(w, r, http.StatusBadRequest, "from is required")
+		return
+	}
+	d := receipts.Parse(req.From, req.Subject, req.Text, time.Now())
+	d.ID = idgen.New()
+	if err := s.store.AddDraftExpense(r.Context(), d); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save draft expense")
+		return
+	}
+	writeJSON(w, http.StatusCreated, d)
+}
+
+// handleOCRReceipt accepts a multipart upload of a receipt photo, runs it
+// through the server's configured OCR processor, and stores the extracted
+// amount/date/merchant as a draft expense awaiting confirmation, the same
+// review step forwarded receipt emails go through.
+func (s *Server) handleOCRReceipt(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "missing file")
+		return
+	}
+	defer file.Close()
+	image, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	text, err := s.ocrProcessor.Extract(image)
+	if err != nil {
+		writeError(w, r, http.StatusBadGateway, "ocr processing failed")
+		return
+	}
+
+	d := receipts.ParseText(text, time.Now())
+	d.ID = idgen.New()
+	if err := s.store.AddDraftExpense(r.Context(), d); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save draft expense")
+		return
+	}
+	writeJSON(w, http.StatusCreated, d)
+}
+
+func (s *Server) handleListDraftExpenses(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.store.ListDraftExpenses())
+}
+
+// confirmDraftRequest lets the user correct any field the parser guessed
+// wrong before it becomes a real expense.
+type confirmDraftRequest struct {
+	Amount   *money.Money `json:"amount"`
+	Category *string      `json:"category"`
+	Merchant *string      `json:"merchant"`
+	Note     *string      `json:"note"`
+	Date     *string      `json:"date"`
+}
+
+func (s *Server) handleConfirmDraftExpense(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.store.GetDraftExpense(r.PathValue("id"))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "draft not found")
+		return
+	}
+	var req confirmDraftRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+	if req.Amount != nil {
+		d.Amount = *req.Amount
+	}
+	if req.Category != nil {
+		d.Category = *req.Category
+	}
+	if req.Merchant != nil {
+		d.Merchant = *req.Merchant
+	}
+	if req.Note != nil {
+		d.Note = *req.Note
+	}
+	if req.Date != nil {
+		parsed, err := time.Parse("2006-01-02", *req.Date)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "date must be in YYYY-MM-DD format")
+			return
+		}
+		d.Date = parsed
+	}
+	if d.Category == "" {
+		writeError(w, r, http.StatusBadRequest, "category is required")
+		return
+	}
+
+	e := &model.Expense{
+		ID:        idgen.New(),
+		Amount:    d.Amount,
+		Category:  d.Category,
+		Merchant:  d.Merchant,
+		Note:      d.Note,
+		Date:      civildate.FromTime(d.Date),
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.Add(r.Context(), e); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save expense")
+		return
+	}
+	if err := s.store.DeleteDraftExpense(r.Context(), d.ID); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to remove confirmed draft")
+		return
+	}
+	writeJSON(w, http.StatusCreated, e)
+}
+
+func (s *Server) handleRejectDraftExpense(w http.ResponseWriter, r *http.Request) {
+	if err := s.store.DeleteDraftExpense(r.Context(), r.PathValue("id")); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to reject draft")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
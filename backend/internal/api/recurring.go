@@ -0,0 +1,325 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/ical"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/recurring"
+)
+
+// handleListRecurring lists the caller's recurring patterns. Deactivated
+// patterns are omitted unless ?include_inactive=true, so a deleted-but-not-
+// purged pattern can still be found and revived.
+func (s *Server) handleListRecurring(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "include_inactive") {
+		return
+	}
+	uid := userID(r)
+	includeInactive := r.URL.Query().Get("include_inactive") == "true"
+	var owned []*model.RecurringPattern
+	for _, p := range s.store.ListRecurring(includeInactive) {
+		if p.UserID == uid {
+			owned = append(owned, p)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+// handleRecurringFeedToken returns the token to append to the .ics feed URL
+// as ?token=. The underlying secret itself is never exposed.
+func (s *Server) handleRecurringFeedToken(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"token": ical.Sign(s.store.Settings().ICSSecret)})
+}
+
+type createRecurringRequest struct {
+	Amount           money.Money     `json:"amount"`
+	Category         string          `json:"category"`
+	Merchant         string          `json:"merchant"`
+	Vendor           string          `json:"vendor"`
+	Note             string          `json:"note"`
+	Frequency        model.Frequency `json:"frequency"`
+	StartDate        time.Time       `json:"start_date"`
+	RemindDaysBefore int             `json:"remind_days_before"`
+}
+
+func (s *Server) handleCreateRecurring(w http.ResponseWriter, r *http.Request) {
+	var req createRecurringRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Category == "" {
+		writeError(w, r, http.StatusBadRequest, "category is required")
+		return
+	}
+	switch req.Frequency {
+	case model.FrequencyDaily, model.FrequencyWeekly, model.FrequencyMonthly, model.FrequencyYearly:
+	default:
+		writeError(w, r, http.StatusBadRequest, "invalid frequency")
+		return
+	}
+	if req.StartDate.IsZero() {
+		req.StartDate = time.Now()
+	}
+	if req.RemindDaysBefore < 0 {
+		writeError(w, r, http.StatusBadRequest, "remind_days_before must not be negative")
+		return
+	}
+	now := time.Now()
+	p := &model.RecurringPattern{
+		ID:               idgen.New(),
+		Amount:           req.Amount,
+		Category:         req.Category,
+		Merchant:         req.Merchant,
+		Vendor:           req.Vendor,
+		Note:             req.Note,
+		Frequency:        req.Frequency,
+		StartDate:        req.StartDate,
+		RemindDaysBefore: req.RemindDaysBefore,
+		Active:           true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		UserID:           userID(r),
+	}
+	if err := s.store.AddRecurring(r.Context(), p); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save pattern")
+		return
+	}
+	writeJSON(w, http.StatusCreated, p)
+}
+
+// handleDeleteRecurring stops a recurring pattern. By default (and with
+// ?mode=deactivate) it's marked inactive, preserving its history; passing
+// ?mode=purge instead removes it entirely.
+func (s *Server) handleDeleteRecurring(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "mode") {
+		return
+	}
+	id := r.PathValue("id")
+	if _, ok := s.store.GetRecurring(id); !ok {
+		writeError(w, r, http.StatusNotFound, "pattern not found")
+		return
+	}
+	switch mode := r.URL.Query().Get("mode"); mode {
+	case "", "deactivate":
+		if err := s.store.DeactivateRecurring(r.Context(), id); err != nil {
+			writeError(w, r, http.StatusInternalServerError, "failed to deactivate pattern")
+			return
+		}
+	case "purge":
+		if err := s.store.DeleteRecurring(r.Context(), id); err != nil {
+			writeError(w, r, http.StatusInternalServerError, "failed to delete pattern")
+			return
+		}
+	default:
+		writeError(w, r, http.StatusBadRequest, `mode must be "deactivate" or "purge"`)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleActivateRecurring reactivates a pattern previously stopped with
+// ?mode=deactivate (the default for DELETE), reviving it without having to
+// recreate it from scratch.
+func (s *Server) handleActivateRecurring(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.store.GetRecurring(id); !ok {
+		writeError(w, r, http.StatusNotFound, "pattern not found")
+		return
+	}
+	if err := s.store.ActivateRecurring(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to activate pattern")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// recurringBundleSchemaVersion is bumped whenever recurringBundleItem's
+// shape changes incompatibly, so an older instance importing a newer
+// bundle fails loudly instead of silently dropping fields it doesn't
+// recognize.
+const recurringBundleSchemaVersion = 1
+
+// recurringBundleItem is the portable subset of a RecurringPattern: just
+// enough to recreate the bill or income on another instance. ID, Active,
+// the timestamps, LastReminderSent, and UserID are all instance- or
+// account-specific and deliberately left out, so importing a bundle never
+// collides with or impersonates the exporting household's own patterns.
+type recurringBundleItem struct {
+	Amount           money.Money     `json:"amount"`
+	Category         string          `json:"category"`
+	Merchant         string          `json:"merchant,omitempty"`
+	Vendor           string          `json:"vendor,omitempty"`
+	Note             string          `json:"note,omitempty"`
+	Frequency        model.Frequency `json:"frequency"`
+	StartDate        time.Time       `json:"start_date"`
+	RemindDaysBefore int             `json:"remind_days_before,omitempty"`
+}
+
+// recurringBundle is a "standard household bills" setup shared between
+// instances, with no expense history attached.
+type recurringBundle struct {
+	SchemaVersion int                   `json:"schema_version"`
+	ExportedAt    time.Time             `json:"exported_at"`
+	Patterns      []recurringBundleItem `json:"patterns"`
+}
+
+// handleExportRecurringBundle returns the caller's recurring patterns as a
+// portable JSON bundle, for sharing a standard set of bills between
+// instances without moving expense history. Deactivated patterns are
+// omitted unless ?include_inactive=true.
+func (s *Server) handleExportRecurringBundle(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "include_inactive") {
+		return
+	}
+	uid := userID(r)
+	includeInactive := r.URL.Query().Get("include_inactive") == "true"
+	bundle := recurringBundle{SchemaVersion: recurringBundleSchemaVersion, ExportedAt: time.Now()}
+	for _, p := range s.store.ListRecurring(includeInactive) {
+		if p.UserID != uid {
+			continue
+		}
+		bundle.Patterns = append(bundle.Patterns, recurringBundleItem{
+			Amount:           p.Amount,
+			Category:         p.Category,
+			Merchant:         p.Merchant,
+			Vendor:           p.Vendor,
+			Note:             p.Note,
+			Frequency:        p.Frequency,
+			StartDate:        p.StartDate,
+			RemindDaysBefore: p.RemindDaysBefore,
+		})
+	}
+	writeJSON(w, http.StatusOK, bundle)
+}
+
+// recurringBundleImportResult reports what handleImportRecurringBundle did
+// with each entry in the bundle, mirroring importResult's shape for the
+// CSV/preset importers.
+type recurringBundleImportResult struct {
+	Imported int      `json:"imported"`
+	Skipped  int      `json:"skipped"`
+	Errors   []string `json:"errors,omitempty"`
+	DryRun   bool     `json:"dry_run"`
+}
+
+// handleImportRecurringBundle recreates the patterns in a bundle produced
+// by handleExportRecurringBundle (or a hand-written equivalent) as new,
+// active patterns owned by the caller. ?dry_run=true validates without
+// creating anything, so a bundle from an unfamiliar source can be checked
+// first.
+func (s *Server) handleImportRecurringBundle(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "dry_run") {
+		return
+	}
+	var bundle recurringBundle
+	if err := json.NewDecoder(r.Body).Decode(&bundle); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if bundle.SchemaVersion != recurringBundleSchemaVersion {
+		writeError(w, r, http.StatusBadRequest, "unsupported schema_version")
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	uid := userID(r)
+	now := time.Now()
+	result := recurringBundleImportResult{DryRun: dryRun}
+	for i, item := range bundle.Patterns {
+		if item.Category == "" {
+			result.Skipped++
+			result.Errors = append(result.Errors, fmt.Sprintf("pattern %d: category is required", i))
+			continue
+		}
+		switch item.Frequency {
+		case model.FrequencyDaily, model.FrequencyWeekly, model.FrequencyMonthly, model.FrequencyYearly:
+		default:
+			result.Skipped++
+			result.Errors = append(result.Errors, fmt.Sprintf("pattern %d: invalid frequency", i))
+			continue
+		}
+		if item.StartDate.IsZero() {
+			item.StartDate = now
+		}
+		if dryRun {
+			result.Imported++
+			continue
+		}
+		p := &model.RecurringPattern{
+			ID:               idgen.New(),
+			Amount:           item.Amount,
+			Category:         item.Category,
+			Merchant:         item.Merchant,
+			Vendor:           item.Vendor,
+			Note:             item.Note,
+			Frequency:        item.Frequency,
+			StartDate:        item.StartDate,
+			RemindDaysBefore: item.RemindDaysBefore,
+			Active:           true,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+			UserID:           uid,
+		}
+		if err := s.store.AddRecurring(r.Context(), p); err != nil {
+			result.Skipped++
+			result.Errors = append(result.Errors, fmt.Sprintf("pattern %d: failed to save: %s", i, err.Error()))
+			continue
+		}
+		result.Imported++
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleListVendors summarizes active recurring patterns grouped by Vendor,
+// so subscriptions from the same provider billed under different merchant
+// descriptors (e.g. two Amazon charges) show up as one combined monthly
+// cost.
+func (s *Server) handleListVendors(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.RecurringPattern
+	for _, p := range s.store.ListRecurring(false) {
+		if p.UserID == uid {
+			owned = append(owned, p)
+		}
+	}
+	writeJSON(w, http.StatusOK, recurring.ByVendor(owned))
+}
+
+// handleUpcomingRecurring returns projected occurrences of active patterns
+// over the next 90 days by default.
+func (s *Server) handleUpcomingRecurring(w http.ResponseWriter, r *http.Request) {
+	from := time.Now()
+	to := from.AddDate(0, 0, 90)
+	writeJSON(w, http.StatusOK, recurring.Upcoming(s.store.ListRecurring(false), from, to))
+}
+
+// handleListSweeps returns the recurring-pattern reminder sweep history,
+// most recent first, so a user can see why an expected bill reminder did
+// or didn't fire.
+func (s *Server) handleListSweeps(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.store.ListSweepLogs())
+}
+
+// handleUpcomingICS serves the same projection as handleUpcomingRecurring in
+// iCalendar format, for subscribing from a calendar app. Since calendar
+// clients can't send auth headers, the URL itself must carry a token signed
+// with the account's ICSSecret.
+func (s *Server) handleUpcomingICS(w http.ResponseWriter, r *http.Request) {
+	secret := s.store.Settings().ICSSecret
+	if !ical.Verify(secret, r.URL.Query().Get("token")) {
+		writeError(w, r, http.StatusUnauthorized, "invalid or missing token")
+		return
+	}
+	from := time.Now()
+	to := from.AddDate(0, 0, 90)
+	occurrences := recurring.Upcoming(s.store.ListRecurring(false), from, to)
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(ical.RenderUpcoming(occurrences))
+}
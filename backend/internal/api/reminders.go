@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/recurring"
+)
+
+func (s *Server) handleListReminders(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Reminder
+	for _, rem := range s.store.ListReminders(false) {
+		if rem.UserID == uid {
+			owned = append(owned, rem)
+		}
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+type createReminderRequest struct {
+	Category string      `json:"category"`
+	Merchant string      `json:"merchant"`
+	Amount   money.Money `json:"amount"`
+	DueDate  time.Time   `json:"due_date"`
+	Note     string      `json:"note"`
+}
+
+func (s *Server) handleCreateReminder(w http.ResponseWriter, r *http.Request) {
+	var req createReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Category == "" {
+		writeError(w, r, http.StatusBadRequest, "category is required")
+		return
+	}
+	if req.DueDate.IsZero() {
+		writeError(w, r, http.StatusBadRequest, "due_date is required")
+		return
+	}
+	rem := &model.Reminder{
+		ID:        idgen.New(),
+		Category:  req.Category,
+		Merchant:  req.Merchant,
+		Amount:    req.Amount,
+		DueDate:   req.DueDate,
+		Note:      req.Note,
+		CreatedAt: time.Now(),
+		UserID:    userID(r),
+	}
+	if err := s.store.AddReminder(r.Context(), rem); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save reminder")
+		return
+	}
+	writeJSON(w, http.StatusCreated, rem)
+}
+
+func (s *Server) handleDeleteReminder(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	rem, ok := s.store.GetReminder(id)
+	if !ok || rem.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "reminder not found")
+		return
+	}
+	if err := s.store.DeleteReminder(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete reminder")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// upcomingItem is a single future bill, either a projected recurring
+// occurrence or a one-off reminder, merged into one chronological list.
+type upcomingItem struct {
+	Date     time.Time   `json:"date"`
+	Category string      `json:"category"`
+	Merchant string      `json:"merchant,omitempty"`
+	Amount   money.Money `json:"amount"`
+	Note     string      `json:"note,omitempty"`
+	Source   string      `json:"source"`
+}
+
+// handleUpcoming returns the next 90 days of recurring occurrences and
+// one-off reminders together, sorted by date, so the client can render a
+// single "what's coming up" list without merging two endpoints itself.
+func (s *Server) handleUpcoming(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	from := time.Now()
+	to := from.AddDate(0, 0, 90)
+
+	var ownedPatterns []*model.RecurringPattern
+	for _, p := range s.store.ListRecurring(false) {
+		if p.UserID == uid {
+			ownedPatterns = append(ownedPatterns, p)
+		}
+	}
+
+	var items []upcomingItem
+	for _, occ := range recurring.Upcoming(ownedPatterns, from, to) {
+		items = append(items, upcomingItem{
+			Date:     occ.Date,
+			Category: occ.Pattern.Category,
+			Merchant: occ.Pattern.Merchant,
+			Amount:   occ.Pattern.Amount,
+			Note:     recurring.ExpandNote(occ.Pattern.Note, occ.Date),
+			Source:   "recurring",
+		})
+	}
+	for _, rem := range s.store.ListReminders(false) {
+		if rem.UserID != uid || rem.DueDate.Before(from) || !rem.DueDate.Before(to) {
+			continue
+		}
+		items = append(items, upcomingItem{
+			Date:     rem.DueDate,
+			Category: rem.Category,
+			Merchant: rem.Merchant,
+			Amount:   rem.Amount,
+			Note:     rem.Note,
+			Source:   "reminder",
+		})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Date.Before(items[j].Date) })
+	writeJSON(w, http.StatusOK, items)
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/export"
+	"halclarke97/budgetapp/backend/internal/report"
+)
+
+// handleMonthlyReport renders a monthly totals/category/largest-expenses
+// report. format=pdf returns a PDF; any other value (or omission) returns
+// JSON so the frontend can render its own view of the same data.
+func (s *Server) handleMonthlyReport(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "month", "format") {
+		return
+	}
+	monthParam := r.URL.Query().Get("month")
+	if monthParam == "" {
+		monthParam = time.Now().Format("2006-01")
+	}
+	month, err := time.Parse("2006-01", monthParam)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid month, expected YYYY-MM")
+		return
+	}
+
+	m := report.BuildMonthly(ownedExpenses(s, r), month)
+
+	if r.URL.Query().Get("format") == "pdf" {
+		data := export.MonthlyReportPDF(m)
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="report-`+m.Month+`.pdf"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, m)
+}
+
+// handleTaxReport returns deductible spending by category and total tax
+// paid for the given calendar year, defaulting to the current year.
+func (s *Server) handleTaxReport(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "year") {
+		return
+	}
+	yearParam := r.URL.Query().Get("year")
+	year := time.Now().Year()
+	if yearParam != "" {
+		parsed, err := strconv.Atoi(yearParam)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid year")
+			return
+		}
+		year = parsed
+	}
+	writeJSON(w, http.StatusOK, report.BuildTax(ownedExpenses(s, r), year))
+}
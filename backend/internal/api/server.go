@@ -0,0 +1,516 @@
+// Package api wires the HTTP surface of budgetapp to the store.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/attachments"
+	"halclarke97/budgetapp/backend/internal/auth"
+	"halclarke97/budgetapp/backend/internal/i18n"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/ocr"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// requestTimeout bounds how long any single request's context stays valid,
+// so a handler stuck on a slow store write can't hold its goroutine (and the
+// store's lock) forever.
+const requestTimeout = 25 * time.Second
+
+// Options configures cross-cutting behavior of a Server that varies by
+// deployment: which browser origins may call the API, and whether requests
+// need to authenticate at all.
+type Options struct {
+	// CORSOrigins lists the origins allowed to make cross-origin requests.
+	// Empty means no CORS headers are sent, i.e. same-origin only.
+	CORSOrigins []string
+	// AuthMode is "session" (the default: every non-public route requires a
+	// session cookie or API key), "none" (every request is treated as
+	// already authenticated, for local development), or "profile" (no
+	// login at all: the caller-supplied X-Profile header, defaulting to
+	// "default", is used as the data partition — see profileHeader — for
+	// running separate named profiles, e.g. "personal" and
+	// "side-business", out of one instance without standing up sessions,
+	// passwords, or API keys for each). Profiles share one Settings, SMTP
+	// config, and notification channels; only records that carry a UserID
+	// (expenses, recurring patterns, budgets, reminders, income, debts,
+	// accounts, and projects) are split per-profile. Category is the one
+	// exception: it's a shared, name-keyed display registry (see
+	// Store.ensureCategory) rather than a per-profile record, so category
+	// colors, icons, and defaults are always visible to and editable by
+	// every profile.
+	AuthMode string
+	// ReportsDir is where scheduled monthly reports are saved, and where
+	// GET /api/reports looks for them. Empty disables the endpoint.
+	ReportsDir string
+	// AttachmentsDir is where uploaded receipt attachments are saved. Empty
+	// disables attachment uploads.
+	AttachmentsDir string
+	// AttachmentScanClamAVAddr, if set, is a clamd socket
+	// (see attachments.DefaultScanner.ClamAVAddr) that every uploaded
+	// attachment is streamed through before it's persisted. Empty skips
+	// the malware scan, leaving type sniffing and the dimension check as
+	// the only gates.
+	AttachmentScanClamAVAddr string
+	// SeparateAdminListener excludes /api/admin/* and /debug/pprof/* from
+	// the mux returned by NewServer's Handler, so a public-facing listener
+	// built from it can't reach them at all (not even the requireAdmin
+	// token check runs, since the routes simply aren't registered). The
+	// caller is then expected to serve AdminHandler on a second, non-public
+	// listener instead. False keeps today's behavior: admin/debug routes
+	// live on the same mux, gated only by requireAdmin.
+	SeparateAdminListener bool
+}
+
+// maxAttachmentImageDimension caps the width and height, in pixels, of an
+// uploaded image attachment, so a shared household instance can't be used
+// to store or serve arbitrarily large decompression-bomb-style images.
+const maxAttachmentImageDimension = 8000
+
+// Server holds the dependencies shared by all HTTP handlers.
+type Server struct {
+	store                 *store.Store
+	sessions              *auth.Sessions
+	lockouts              *auth.Lockouts
+	erasureTokens         *auth.ErasureTokens
+	corsOrigins           map[string]bool
+	authDisabled          bool
+	profileMode           bool
+	reportsDir            string
+	attachmentsDir        string
+	attachmentScan        attachments.Scanner
+	ocrProcessor          ocr.Processor
+	mux                   *http.ServeMux
+	adminMux              *http.ServeMux
+	separateAdminListener bool
+}
+
+// NewServer builds a Server with all routes registered.
+func NewServer(s *store.Store, opts Options) *Server {
+	origins := make(map[string]bool, len(opts.CORSOrigins))
+	for _, o := range opts.CORSOrigins {
+		origins[o] = true
+	}
+	srv := &Server{
+		store:          s,
+		sessions:       auth.NewSessions(),
+		lockouts:       auth.NewLockouts(),
+		erasureTokens:  auth.NewErasureTokens(),
+		corsOrigins:    origins,
+		authDisabled:   opts.AuthMode == "none",
+		profileMode:    opts.AuthMode == "profile",
+		reportsDir:     opts.ReportsDir,
+		attachmentsDir: opts.AttachmentsDir,
+		attachmentScan: attachments.DefaultScanner{
+			MaxImageDimension: maxAttachmentImageDimension,
+			ClamAVAddr:        opts.AttachmentScanClamAVAddr,
+		},
+		ocrProcessor:          ocr.TesseractProcessor{},
+		mux:                   http.NewServeMux(),
+		separateAdminListener: opts.SeparateAdminListener,
+
+	}
+	srv.routes()
+	return srv
+}
+
+// AdminHandler serves /api/admin/* and /debug/pprof/*, each still gated by
+// requireAdmin's token check. When Options.SeparateAdminListener is false
+// (the default), this is the same mux as the public Handler; the caller
+// should only stand up a second listener for it when SeparateAdminListener
+// is true, since otherwise the routes are already reachable on the public
+// one.
+func (s *Server) AdminHandler() http.Handler {
+	return s.adminMux
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("GET /healthz", s.handleHealthz)
+	s.mux.HandleFunc("GET /readyz", s.handleReadyz)
+	s.mux.HandleFunc("GET /api/profile", s.handleActiveProfile)
+	s.mux.HandleFunc("GET /api/bootstrap", s.handleBootstrap)
+	s.mux.HandleFunc("GET /api/expenses", s.handleListExpenses)
+	s.mux.HandleFunc("POST /api/expenses", s.handleCreateExpense)
+	s.mux.HandleFunc("GET /api/expenses/geo", s.handleExpensesGeo)
+	s.mux.HandleFunc("GET /api/expenses/frequent", s.handleFrequentExpenses)
+	s.mux.HandleFunc("POST /api/expenses/{id}/clone", s.handleCloneExpense)
+	s.mux.HandleFunc("POST /api/expenses/{id}/attachments", s.handleUploadAttachment)
+	s.mux.HandleFunc("GET /api/expenses/{id}/attachments", s.handleListAttachments)
+	s.mux.HandleFunc("GET /api/attachments/usage", s.handleAttachmentUsage)
+	s.mux.HandleFunc("GET /api/attachments/{id}", s.handleDownloadAttachment)
+	s.mux.HandleFunc("DELETE /api/attachments/{id}", s.handleDeleteAttachment)
+	s.mux.HandleFunc("GET /api/stats/trend", s.handleStatsTrend)
+	s.mux.HandleFunc("GET /api/stats/categories/{category}/trend", s.handleCategoryTrend)
+	s.mux.HandleFunc("GET /api/stats/heatmap", s.handleStatsHeatmap)
+	s.mux.HandleFunc("GET /api/stats/burn", s.handleStatsBurn)
+	s.mux.HandleFunc("GET /api/stats/streaks", s.handleStatsStreaks)
+	s.mux.HandleFunc("GET /api/stats/top", s.handleStatsTop)
+	s.mux.HandleFunc("GET /api/stats/anomalies", s.handleStatsAnomalies)
+	s.mux.HandleFunc("GET /api/stats/weekday", s.handleStatsWeekday)
+	s.mux.HandleFunc("GET /api/stats/cashflow", s.handleStatsCashflow)
+	s.mux.HandleFunc("GET /api/stats/classification", s.handleStatsClassification)
+	s.mux.HandleFunc("GET /api/stats/vs-budget", s.handleStatsVsBudget)
+	s.mux.HandleFunc("GET /api/income", s.handleListIncome)
+	s.mux.HandleFunc("POST /api/income", s.handleCreateIncome)
+	s.mux.HandleFunc("DELETE /api/income/{id}", s.handleDeleteIncome)
+	s.mux.HandleFunc("GET /api/accounts", s.handleListAccounts)
+	s.mux.HandleFunc("POST /api/accounts", s.handleCreateAccount)
+	s.mux.HandleFunc("DELETE /api/accounts/{id}", s.handleDeleteAccount)
+	s.mux.HandleFunc("GET /api/accounts/balances", s.handleAccountBalances)
+	s.mux.HandleFunc("POST /api/accounts/{id}/reconcile", s.handleReconcileAccount)
+	s.mux.HandleFunc("GET /api/accounts/{id}/reconciliations", s.handleListReconciliations)
+	s.mux.HandleFunc("GET /api/debts", s.handleListDebts)
+	s.mux.HandleFunc("POST /api/debts", s.handleCreateDebt)
+	s.mux.HandleFunc("DELETE /api/debts/{id}", s.handleDeleteDebt)
+	s.mux.HandleFunc("GET /api/debts/{id}/payments", s.handleListDebtPayments)
+	s.mux.HandleFunc("GET /api/debts/payoff", s.handleDebtPayoff)
+	s.mux.HandleFunc("GET /api/projects", s.handleListProjects)
+	s.mux.HandleFunc("POST /api/projects", s.handleCreateProject)
+	s.mux.HandleFunc("DELETE /api/projects/{id}", s.handleDeleteProject)
+	s.mux.HandleFunc("GET /api/projects/{id}/summary", s.handleProjectSummary)
+	s.mux.HandleFunc("PUT /api/projects/{id}/retention", s.handleUpdateProjectRetention)
+	s.mux.HandleFunc("GET /api/settings", s.handleGetSettings)
+	s.mux.HandleFunc("PUT /api/settings", s.handlePutSettings)
+	s.mux.HandleFunc("GET /api/export/xlsx", s.handleExportXLSX)
+	s.mux.HandleFunc("GET /api/export/ynab", s.handleExportYNAB)
+	s.mux.HandleFunc("GET /api/export/firefly", s.handleExportFirefly)
+	s.mux.HandleFunc("GET /api/reports/monthly", s.handleMonthlyReport)
+	s.mux.HandleFunc("GET /api/reports/tax", s.handleTaxReport)
+	s.mux.HandleFunc("GET /api/reports", s.handleListGeneratedReports)
+	s.mux.HandleFunc("GET /api/reports/files/{name}", s.handleDownloadGeneratedReport)
+	s.mux.HandleFunc("POST /api/import/csv", s.handleImportCSV)
+	s.mux.HandleFunc("POST /api/import/qif", s.handleImportQIF)
+	s.mux.HandleFunc("POST /api/import/ynab", s.handleImportYNAB)
+	s.mux.HandleFunc("POST /api/import/mint", s.handleImportMint)
+	s.mux.HandleFunc("GET /api/recurring-expenses", s.handleListRecurring)
+	s.mux.HandleFunc("POST /api/recurring-expenses", s.handleCreateRecurring)
+	s.mux.HandleFunc("DELETE /api/recurring-expenses/{id}", s.handleDeleteRecurring)
+	s.mux.HandleFunc("POST /api/recurring-expenses/{id}/activate", s.handleActivateRecurring)
+	s.mux.HandleFunc("GET /api/vendors", s.handleListVendors)
+	s.mux.HandleFunc("GET /api/recurring-expenses/upcoming", s.handleUpcomingRecurring)
+	s.mux.HandleFunc("GET /api/recurring-expenses/upcoming.ics", s.handleUpcomingICS)
+	s.mux.HandleFunc("GET /api/recurring-expenses/feed-token", s.handleRecurringFeedToken)
+	s.mux.HandleFunc("GET /api/recurring-expenses/sweeps", s.handleListSweeps)
+	s.mux.HandleFunc("GET /api/recurring-expenses/export", s.handleExportRecurringBundle)
+	s.mux.HandleFunc("POST /api/recurring-expenses/import", s.handleImportRecurringBundle)
+	s.mux.HandleFunc("GET /api/reminders", s.handleListReminders)
+	s.mux.HandleFunc("POST /api/reminders", s.handleCreateReminder)
+	s.mux.HandleFunc("DELETE /api/reminders/{id}", s.handleDeleteReminder)
+	s.mux.HandleFunc("GET /api/upcoming", s.handleUpcoming)
+	s.mux.HandleFunc("GET /api/overview/upcoming", s.handleOverviewUpcoming)
+	s.mux.HandleFunc("GET /api/meta", s.handleMeta)
+	s.mux.HandleFunc("GET /api/calendar", s.handleCalendar)
+	s.mux.HandleFunc("GET /api/suggest", s.handleSuggest)
+	s.mux.HandleFunc("GET /api/categories", s.handleListCategories)
+	s.mux.HandleFunc("PUT /api/categories/{name}", s.handleUpdateCategoryStyle)
+	s.mux.HandleFunc("PUT /api/categories/{name}/defaults", s.handleUpdateCategoryDefaults)
+	s.mux.HandleFunc("PUT /api/categories/{name}/retention", s.handleUpdateCategoryRetention)
+	s.mux.HandleFunc("GET /api/period-lock", s.handleGetPeriodLock)
+	// Closing/reopening a period locks or unlocks every user's expenses
+	// store-wide (ClosePeriod has no per-user concept), so unlike the rest
+	// of this file these two are gated behind the operator's admin token
+	// rather than being an ordinary authenticated-user action.
+	s.mux.HandleFunc("POST /api/period-lock/close", requireAdmin(s.handleClosePeriod))
+	s.mux.HandleFunc("POST /api/period-lock/reopen", requireAdmin(s.handleReopenPeriod))
+	s.mux.HandleFunc("GET /api/periods", s.handleListCloseouts)
+	s.mux.HandleFunc("POST /api/periods/{month}/close", s.handleCloseoutMonth)
+	s.mux.HandleFunc("POST /api/digest/send-test", s.handleSendTestDigest)
+	s.mux.HandleFunc("POST /api/integrations/google-sheets/sync", s.handleSyncGoogleSheets)
+	s.mux.HandleFunc("GET /api/notification-channels", s.handleListChannels)
+	s.mux.HandleFunc("POST /api/notification-channels", s.handleCreateChannel)
+	s.mux.HandleFunc("DELETE /api/notification-channels/{id}", s.handleDeleteChannel)
+	s.mux.HandleFunc("GET /api/alert-rules", s.handleListAlertRules)
+	s.mux.HandleFunc("POST /api/alert-rules", s.handleCreateAlertRule)
+	s.mux.HandleFunc("DELETE /api/alert-rules/{id}", s.handleDeleteAlertRule)
+	s.mux.HandleFunc("GET /api/alert-rules/history", s.handleListAlertHistory)
+	s.mux.HandleFunc("GET /api/budgets", s.handleListBudgets)
+	s.mux.HandleFunc("POST /api/budgets", s.handleCreateBudget)
+	s.mux.HandleFunc("DELETE /api/budgets/{id}", s.handleDeleteBudget)
+	s.mux.HandleFunc("GET /api/budgets/status", s.handleBudgetStatus)
+	s.mux.HandleFunc("GET /api/budgets/safe-to-spend", s.handleSafeToSpend)
+	s.mux.HandleFunc("GET /api/challenges", s.handleListChallenges)
+	s.mux.HandleFunc("POST /api/challenges", s.handleCreateChallenge)
+	s.mux.HandleFunc("DELETE /api/challenges/{id}", s.handleDeleteChallenge)
+	s.mux.HandleFunc("GET /api/challenges/status", s.handleChallengeStatus)
+	s.mux.HandleFunc("POST /api/simulate", s.handleSimulate)
+	s.mux.HandleFunc("GET /api/bank-accounts", s.handleListLinkedAccounts)
+	s.mux.HandleFunc("POST /api/bank-accounts", s.handleLinkAccount)
+	s.mux.HandleFunc("DELETE /api/bank-accounts/{id}", s.handleUnlinkAccount)
+	s.mux.HandleFunc("POST /api/bank-accounts/{id}/sync", s.handleSyncAccount)
+	s.mux.HandleFunc("POST /api/receipts/inbound", s.handleInboundReceipt)
+	s.mux.HandleFunc("POST /api/receipts/ocr", s.handleOCRReceipt)
+	s.mux.HandleFunc("GET /api/receipts/drafts", s.handleListDraftExpenses)
+	s.mux.HandleFunc("POST /api/receipts/drafts/{id}/confirm", s.handleConfirmDraftExpense)
+	s.mux.HandleFunc("DELETE /api/receipts/drafts/{id}", s.handleRejectDraftExpense)
+	s.mux.HandleFunc("POST /api/auth/register", s.handleRegister)
+	s.mux.HandleFunc("POST /api/auth/login", s.handleLogin)
+	s.mux.HandleFunc("POST /api/auth/logout", s.handleLogout)
+	s.mux.HandleFunc("GET /api/keys", s.handleListKeys)
+	s.mux.HandleFunc("POST /api/keys", s.handleCreateKey)
+	s.mux.HandleFunc("DELETE /api/keys/{id}", s.handleRevokeKey)
+	s.mux.HandleFunc("GET /api/webhooks", s.handleListWebhooks)
+	s.mux.HandleFunc("POST /api/webhooks", s.handleCreateWebhook)
+	s.mux.HandleFunc("DELETE /api/webhooks/{id}", s.handleDeleteWebhook)
+	s.mux.HandleFunc("GET /api/webhooks/{id}/deliveries", s.handleListWebhookDeliveries)
+	s.mux.HandleFunc("POST /api/webhooks/{id}/deliveries/{deliveryId}/redeliver", s.handleRedeliverWebhook)
+	s.mux.HandleFunc("GET /api/triggers/new-expense", s.handleTriggerNewExpense)
+	s.mux.HandleFunc("GET /api/triggers/budget-exceeded", s.handleTriggerBudgetExceeded)
+	s.mux.HandleFunc("POST /api/triggers/{trigger}/subscribe", s.handleSubscribeTrigger)
+	s.mux.HandleFunc("DELETE /api/triggers/{trigger}/subscribe/{id}", s.handleUnsubscribeTrigger)
+	s.mux.HandleFunc("GET /api/audit-events", s.handleListAuditEvents)
+	s.mux.HandleFunc("GET /api/me/sessions", s.handleListSessions)
+	s.mux.HandleFunc("DELETE /api/me/sessions/{id}", s.handleRevokeSession)
+	s.mux.HandleFunc("GET /api/me/export", s.handleExportMe)
+	s.mux.HandleFunc("POST /api/me/delete-confirmation", s.handleRequestErasure)
+	s.mux.HandleFunc("DELETE /api/me", s.handleEraseMe)
+	s.mux.HandleFunc("GET /api/sync/blobs", s.handleListSyncBlobs)
+	s.mux.HandleFunc("GET /api/sync/blobs/{id}", s.handleGetSyncBlob)
+	s.mux.HandleFunc("PUT /api/sync/blobs/{id}", s.handlePutSyncBlob)
+	s.mux.HandleFunc("DELETE /api/sync/blobs/{id}", s.handleDeleteSyncBlob)
+	adminMux := s.mux
+	if s.separateAdminListener {
+		adminMux = http.NewServeMux()
+	}
+	s.adminMux = adminMux
+	s.registerDebugRoutes(adminMux)
+	s.mux.HandleFunc("GET /", s.handleFrontend)
+}
+
+// publicPaths don't require an authenticated session.
+var publicPaths = map[string]bool{
+	"/api/auth/register": true,
+	"/api/auth/login":    true,
+	"/api/auth/logout":   true,
+	"/healthz":           true,
+	"/readyz":            true,
+}
+
+// isPublicPath reports whether r's path may be served without an
+// authenticated session: the explicit publicPaths, admin/debug endpoints
+// (gated separately by requireAdmin on the operator's token instead), plus
+// everything outside /api/, since the frontend itself (including its login
+// screen) has to load before a session can exist.
+func isPublicPath(path string) bool {
+	if publicPaths[path] {
+		return true
+	}
+	if strings.HasPrefix(path, "/api/admin/") {
+		return true
+	}
+	return !strings.HasPrefix(path, "/api/")
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	// Reuse an incoming X-Request-ID so a request can be traced across
+	// services that sit in front of this one; otherwise mint a new one.
+	reqID := r.Header.Get("X-Request-ID")
+	if reqID == "" {
+		reqID = idgen.New()
+	}
+	w.Header().Set("X-Request-ID", reqID)
+	ctx, cancel := context.WithTimeout(withRequestID(r.Context(), reqID), requestTimeout)
+	defer cancel()
+	r = r.WithContext(ctx)
+	s.applyCORS(w, r)
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	uid := s.serve(rec, r)
+	slog.Info("request",
+		"request_id", reqID,
+		"method", r.Method,
+		"path", r.URL.Path,
+		"status", rec.status,
+		"latency_ms", time.Since(start).Milliseconds(),
+		"user_id", uid,
+	)
+}
+
+// applyCORS sets the response headers that let an allowed browser origin
+// call the API cross-origin, including for the preflight OPTIONS request.
+func (s *Server) applyCORS(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" || !s.corsOrigins[origin] {
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Vary", "Origin")
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-CSRF-Token")
+}
+
+type requestIDKey struct{}
+
+func withRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// requestID returns r's correlation ID, set by ServeHTTP on every request,
+// for including in error responses so a user's bug report can be matched
+// back to the server-side access log.
+func requestID(r *http.Request) string {
+	id, _ := r.Context().Value(requestIDKey{}).(string)
+	return id
+}
+
+// profileHeader selects the active data partition in AuthMode "profile",
+// reusing the same UserID-scoped storage every handler already filters on
+// for multi-user accounts, without requiring a session or API key.
+const profileHeader = "X-Profile"
+
+// defaultProfile is used when AuthMode is "profile" and the caller doesn't
+// send profileHeader at all.
+const defaultProfile = "default"
+
+// serve runs the actual routing and auth checks, and returns the
+// authenticated user ID (empty if the request never got that far) for
+// logging.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) string {
+	if isPublicPath(r.URL.Path) {
+		s.mux.ServeHTTP(w, r)
+		return ""
+	}
+	if s.authDisabled {
+		s.mux.ServeHTTP(w, r.WithContext(withUserID(r.Context(), "")))
+		return ""
+	}
+	if s.profileMode {
+		profile := r.Header.Get(profileHeader)
+		if profile == "" {
+			profile = defaultProfile
+		}
+		s.mux.ServeHTTP(w, r.WithContext(withUserID(r.Context(), profile)))
+		return profile
+	}
+	uid, scope, viaSession, ok := s.authenticate(r)
+	if !ok {
+		writeError(w, r, http.StatusUnauthorized, "authentication required")
+		return ""
+	}
+	if scope == model.KeyScopeReadOnly && r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, r, http.StatusForbidden, "this API key is read-only")
+		return uid
+	}
+	// CSRF only applies to cookie-based sessions: a bearer token isn't sent
+	// automatically by the browser, so it can't be forged cross-site.
+	if viaSession && isMutating(r.Method) && !s.validCSRF(r) {
+		writeError(w, r, http.StatusForbidden, "missing or invalid CSRF token")
+		return uid
+	}
+	s.mux.ServeHTTP(w, r.WithContext(withUserID(r.Context(), uid)))
+	return uid
+}
+
+// statusRecorder wraps a ResponseWriter to capture the status code written,
+// since the standard library doesn't expose it after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// validCSRF implements the double-submit cookie pattern: the CSRF cookie
+// set at login must match the X-CSRF-Token header, so a cross-site request
+// (which can't read the cookie to copy it into a header) is rejected.
+func (s *Server) validCSRF(r *http.Request) bool {
+	cookie, err := r.Cookie(csrfCookieName)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	return cookie.Value == r.Header.Get("X-CSRF-Token")
+}
+
+// authenticate resolves the caller's user ID from either a session cookie
+// or an "Authorization: Bearer <api key>" header, and reports the scope the
+// request was authenticated with and whether it came from a session cookie.
+// Session logins are treated as full read-write access.
+func (s *Server) authenticate(r *http.Request) (uid string, scope model.KeyScope, viaSession, ok bool) {
+	if token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found {
+		key, found := s.store.GetAPIKeyByHash(auth.HashAPIKey(token))
+		if !found {
+			return "", "", false, false
+		}
+		return key.UserID, key.Scope, false, true
+	}
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return "", "", false, false
+	}
+	uid, ok = s.sessions.UserID(cookie.Value)
+	return uid, model.KeyScopeReadWrite, true, ok
+}
+
+// requireKnownQueryParams writes a structured 400 response listing every
+// query parameter on r that isn't in allowed, and reports whether r passed
+// (i.e. had none). Handlers that accept query parameters call this first:
+// if !requireKnownQueryParams(w, r, "from", "to") { return }
+func requireKnownQueryParams(w http.ResponseWriter, r *http.Request, allowed ...string) bool {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, a := range allowed {
+		allowedSet[a] = true
+	}
+	var unknown []string
+	for k := range r.URL.Query() {
+		if !allowedSet[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) == 0 {
+		return true
+	}
+	sort.Strings(unknown)
+	msg := "unknown query parameter"
+	lang := i18n.Language(r.Header.Get("Accept-Language"))
+	writeJSON(w, http.StatusBadRequest, map[string]any{
+		"error":      i18n.Translate(msg, lang),
+		"code":       msg,
+		"params":     unknown,
+		"request_id": requestID(r),
+	})
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a JSON error response. msg is a stable, English,
+// hardcoded string at every call site, so it doubles as both the display
+// text and a machine-readable error code: the "code" field never changes
+// even when "error" is localized per the caller's Accept-Language header
+// (see internal/i18n), so a frontend can switch on "code" instead of
+// string-matching English error text.
+func writeError(w http.ResponseWriter, r *http.Request, status int, msg string) {
+	lang := i18n.Language(r.Header.Get("Accept-Language"))
+	writeJSON(w, status, map[string]string{
+		"error":      i18n.Translate(msg, lang),
+		"code":       msg,
+		"request_id": requestID(r),
+	})
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+func (s *Server) handleGetSettings(w http.ResponseWriter, r *http.Request) {
+	v := s.store.Settings()
+	v.ICSSecret = ""
+	v.SMTP.Password = ""
+	v.PlaidSecret = ""
+	v.GoogleSheets.RefreshToken = ""
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) handlePutSettings(w http.ResponseWriter, r *http.Request) {
+	var v settings.Settings
+	if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if v.WeekStart < 0 || v.WeekStart > 6 {
+		writeError(w, r, http.StatusBadRequest, "week_start must be between 0 and 6")
+		return
+	}
+	if v.FiscalMonthStartDay < 1 || v.FiscalMonthStartDay > 28 {
+		writeError(w, r, http.StatusBadRequest, "fiscal_month_start_day must be between 1 and 28")
+		return
+	}
+	if v.Timezone != "" {
+		if _, err := time.LoadLocation(v.Timezone); err != nil {
+			writeError(w, r, http.StatusBadRequest, "unrecognized timezone")
+			return
+		}
+	}
+	if v.DigestFrequency != "" && v.DigestFrequency != "week" && v.DigestFrequency != "month" {
+		writeError(w, r, http.StatusBadRequest, "digest_frequency must be \"week\" or \"month\"")
+		return
+	}
+	if v.DigestEnabled && v.DigestRecipient == "" {
+		writeError(w, r, http.StatusBadRequest, "digest_recipient is required when digest_enabled is true")
+		return
+	}
+	if v.GoogleSheets.SyncMode != "" && v.GoogleSheets.SyncMode != "append" && v.GoogleSheets.SyncMode != "full" {
+		writeError(w, r, http.StatusBadRequest, "google_sheets.sync_mode must be \"append\" or \"full\"")
+		return
+	}
+	if v.RoundingMode != "" && v.RoundingMode != money.RoundHalfUp && v.RoundingMode != money.RoundHalfEven {
+		writeError(w, r, http.StatusBadRequest, "rounding_mode must be \"half_up\" or \"half_even\"")
+		return
+	}
+	if v.OverallMonthlyBudget < 0 {
+		writeError(w, r, http.StatusBadRequest, "overall_monthly_budget must not be negative")
+		return
+	}
+	if v.QuietHoursStart < 0 || v.QuietHoursStart > 23 || v.QuietHoursEnd < 0 || v.QuietHoursEnd > 23 {
+		writeError(w, r, http.StatusBadRequest, "quiet_hours_start and quiet_hours_end must be between 0 and 23")
+		return
+	}
+	if v.Retention.AuditEventDays < 0 || v.Retention.AlertEventDays < 0 || v.Retention.SweepLogDays < 0 || v.Retention.ExpenseDefaultDays < 0 {
+		writeError(w, r, http.StatusBadRequest, "retention day counts must not be negative")
+		return
+	}
+	existing := s.store.Settings()
+	v.ICSSecret = existing.ICSSecret
+	v.DigestLastSent = existing.DigestLastSent
+	v.ReportLastGenerated = existing.ReportLastGenerated
+	v.QueueLastFlushed = existing.QueueLastFlushed
+	if v.SMTP.Password == "" {
+		v.SMTP.Password = existing.SMTP.Password
+	}
+	if v.PlaidSecret == "" {
+		v.PlaidSecret = existing.PlaidSecret
+	}
+	if v.GoogleSheets.RefreshToken == "" {
+		v.GoogleSheets.RefreshToken = existing.GoogleSheets.RefreshToken
+	}
+	if err := s.store.UpdateSettings(r.Context(), v); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save settings")
+		return
+	}
+	v.ICSSecret = ""
+	v.SMTP.Password = ""
+	v.PlaidSecret = ""
+	v.GoogleSheets.RefreshToken = ""
+	writeJSON(w, http.StatusOK, v)
+}
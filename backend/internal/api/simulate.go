@@ -0,0 +1,34 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/simulate"
+)
+
+type simulateRequest struct {
+	Changes []simulate.Change `json:"changes"`
+}
+
+// handleSimulate projects the monthly and annual impact of a set of
+// hypothetical changes (cancel a subscription, add a new cost, cut a
+// category) against the caller's trailing spending averages.
+func (s *Server) handleSimulate(w http.ResponseWriter, r *http.Request) {
+	var req simulateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.Changes) == 0 {
+		writeError(w, r, http.StatusBadRequest, "changes is required")
+		return
+	}
+	result, err := simulate.Run(ownedExpenses(s, r), req.Changes, time.Now())
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,348 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/budgets"
+	"halclarke97/budgetapp/backend/internal/cpi"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/stats"
+)
+
+// ownedExpenses returns the caller's expenses, so every stats endpoint
+// reports on the requester's own spending instead of the whole store.
+func ownedExpenses(s *Server, r *http.Request) []*model.Expense {
+	return filterExpenses(s.store.List(), func(e *model.Expense) bool { return e.UserID == userID(r) })
+}
+
+// ownedIncome returns the caller's income entries.
+func ownedIncome(s *Server, r *http.Request) []*model.Income {
+	uid := userID(r)
+	var owned []*model.Income
+	for _, inc := range s.store.ListIncome() {
+		if inc.UserID == uid {
+			owned = append(owned, inc)
+		}
+	}
+	return owned
+}
+
+// ownedBudgets returns the caller's budgets, active or not.
+func ownedBudgets(s *Server, r *http.Request) []*model.Budget {
+	uid := userID(r)
+	var owned []*model.Budget
+	for _, b := range s.store.ListBudgets(false) {
+		if b.UserID == uid {
+			owned = append(owned, b)
+		}
+	}
+	return owned
+}
+
+// parseGranularity reads the granularity query parameter, defaulting to day.
+func parseGranularity(r *http.Request) (stats.Granularity, bool) {
+	switch g := stats.Granularity(r.URL.Query().Get("granularity")); g {
+	case "":
+		return stats.GranularityDay, true
+	case stats.GranularityDay, stats.GranularityWeek, stats.GranularityMonth:
+		return g, true
+	default:
+		return "", false
+	}
+}
+
+// parseRange reads the from/to query parameters, defaulting to the trailing
+// defaultDays ending today.
+func parseRange(r *http.Request, defaultDays int) (from, to time.Time, ok bool) {
+	now := time.Now()
+	from, to = now.AddDate(0, 0, -defaultDays), now
+	if v := r.URL.Query().Get("from"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		from = t
+	}
+	if v := r.URL.Query().Get("to"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		to = t
+	}
+	return from, to, true
+}
+
+// parseCashflowPeriod reads the period query parameter, defaulting to month.
+func parseCashflowPeriod(r *http.Request) (stats.Granularity, bool) {
+	switch g := stats.Granularity(r.URL.Query().Get("period")); g {
+	case "":
+		return stats.GranularityMonth, true
+	case stats.GranularityWeek, stats.GranularityMonth:
+		return g, true
+	default:
+		return "", false
+	}
+}
+
+func (s *Server) handleStatsCashflow(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "period", "from", "to") {
+		return
+	}
+	g, ok := parseCashflowPeriod(r)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "period must be \"week\" or \"month\"")
+		return
+	}
+	defaultDays := 180
+	if g == stats.GranularityWeek {
+		defaultDays = 84
+	}
+	from, to, ok := parseRange(r, defaultDays)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	points := stats.Cashflow(ownedIncome(s, r), ownedExpenses(s, r), from, to, g, s.store.Settings())
+	writeJSON(w, http.StatusOK, map[string]any{
+		"period": g,
+		"from":   from.Format("2006-01-02"),
+		"to":     to.Format("2006-01-02"),
+		"series": points,
+	})
+}
+
+// handleStatsTrend returns a spending trend series. Passing ?real=true
+// rewrites each bucket into constant currency for the year given by
+// ?base_year (defaulting to the current year), using the CPI table
+// configured in settings; buckets outside the table are left nominal.
+func (s *Server) handleStatsTrend(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "granularity", "from", "to", "real", "base_year") {
+		return
+	}
+	g, ok := parseGranularity(r)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid granularity")
+		return
+	}
+	from, to, ok := parseRange(r, 90)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	points := stats.Trend(ownedExpenses(s, r), from, to, g, s.store.Settings())
+
+	real := r.URL.Query().Get("real") == "true"
+	baseYear := time.Now().Year()
+	if real {
+		var err error
+		baseYear, err = intParam(r, "base_year", baseYear)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid base_year")
+			return
+		}
+		points = stats.AdjustForInflation(points, baseYear, cpi.FromTable(s.store.Settings().CPIIndex), s.store.Settings().RoundingMode)
+	}
+
+	resp := map[string]any{
+		"granularity": g,
+		"from":        from.Format("2006-01-02"),
+		"to":          to.Format("2006-01-02"),
+		"trend":       points,
+		"real":        real,
+	}
+	if real {
+		resp["base_year"] = baseYear
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// handleStatsHeatmap returns per-day spending totals for a calendar year,
+// suitable for a GitHub-contribution-style heatmap.
+func (s *Server) handleStatsHeatmap(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "year") {
+		return
+	}
+	year := time.Now().Year()
+	if v := r.URL.Query().Get("year"); v != "" {
+		y, err := strconv.Atoi(v)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid year")
+			return
+		}
+		year = y
+	}
+	// Unlike most stats endpoints, this can't take the DailyTotals fast path
+	// even when loc is UTC: that cache is a store-wide running total with no
+	// per-user breakdown, so using it here would mix every user's spending
+	// into the caller's heatmap.
+	h := stats.BuildHeatmap(ownedExpenses(s, r), year, s.store.Settings().Location())
+	writeJSON(w, http.StatusOK, h)
+}
+
+// handleStatsBurn returns smoothed 7-day/30-day rolling spending averages
+// and the current daily burn rate.
+func (s *Server) handleStatsBurn(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "from", "to") {
+		return
+	}
+	from, to, ok := parseRange(r, 90)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	writeJSON(w, http.StatusOK, stats.BurnRate(ownedExpenses(s, r), from, to))
+}
+
+// handleStatsStreaks returns no-spend days and streaks over the selected
+// period. Passing ?exclude_bills=true ignores expenses from merchants
+// billed by active recurring patterns, so rent or subscription charges
+// don't break a discretionary-spending streak.
+func (s *Server) handleStatsStreaks(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "from", "to", "exclude_bills") {
+		return
+	}
+	from, to, ok := parseRange(r, 90)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	var billMerchants map[string]bool
+	if r.URL.Query().Get("exclude_bills") == "true" {
+		uid := userID(r)
+		var owned []*model.RecurringPattern
+		for _, p := range s.store.ListRecurring(false) {
+			if p.UserID == uid {
+				owned = append(owned, p)
+			}
+		}
+		billMerchants = stats.BillMerchants(owned)
+	}
+	writeJSON(w, http.StatusOK, stats.NoSpendStreaks(ownedExpenses(s, r), from, to, billMerchants))
+}
+
+// handleStatsTop returns the top merchants by spend and the largest
+// individual expenses for the selected period. The result size for each is
+// controlled independently via merchant_limit and expense_limit (default 5).
+func (s *Server) handleStatsTop(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "from", "to", "merchant_limit", "expense_limit") {
+		return
+	}
+	from, to, ok := parseRange(r, 30)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	merchantLimit, err := intParam(r, "merchant_limit", 5)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid merchant_limit")
+		return
+	}
+	expenseLimit, err := intParam(r, "expense_limit", 5)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid expense_limit")
+		return
+	}
+	expenses := ownedExpenses(s, r)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"top_merchants":    stats.TopMerchants(expenses, from, to, merchantLimit),
+		"largest_expenses": stats.LargestExpenses(expenses, from, to, expenseLimit),
+	})
+}
+
+func intParam(r *http.Request, name string, def int) (int, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return def, nil
+	}
+	return strconv.Atoi(v)
+}
+
+// handleStatsAnomalies flags expenses that are statistical outliers within
+// their category, defaulting to 3 standard deviations above the category
+// mean.
+func (s *Server) handleStatsAnomalies(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "threshold") {
+		return
+	}
+	threshold := 3.0
+	if v := r.URL.Query().Get("threshold"); v != "" {
+		t, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid threshold")
+			return
+		}
+		threshold = t
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"anomalies": stats.Anomalies(ownedExpenses(s, r), threshold),
+	})
+}
+
+// handleStatsClassification returns business vs. personal spend totals,
+// with a category breakdown of the business side, for the selected period.
+func (s *Server) handleStatsClassification(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "from", "to") {
+		return
+	}
+	from, to, ok := parseRange(r, 30)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	writeJSON(w, http.StatusOK, stats.ByClassification(ownedExpenses(s, r), from, to))
+}
+
+// handleStatsVsBudget returns, for every defined budget, the category's
+// limit, spend-to-date, remaining amount, and percent used for the period
+// currently in progress, so a single call can drive budget progress bars.
+func (s *Server) handleStatsVsBudget(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r) {
+		return
+	}
+	statuses := budgets.Compute(ownedBudgets(s, r), ownedExpenses(s, r), s.store.Settings(), time.Now())
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+// handleStatsWeekday returns average spend broken down by weekday and by
+// hour of day.
+func (s *Server) handleStatsWeekday(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r) {
+		return
+	}
+	weekdays, hours := stats.ByWeekdayAndHour(ownedExpenses(s, r))
+	writeJSON(w, http.StatusOK, map[string]any{
+		"by_weekday": weekdays,
+		"by_hour":    hours,
+	})
+}
+
+// handleCategoryTrend returns the trend series for a single category,
+// defaulting to the trailing 12 months so callers can chart a full year
+// without specifying an explicit range.
+func (s *Server) handleCategoryTrend(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "granularity", "from", "to") {
+		return
+	}
+	category := r.PathValue("category")
+	g, ok := parseGranularity(r)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid granularity")
+		return
+	}
+	from, to, ok := parseRange(r, 365)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "invalid from/to date")
+		return
+	}
+	points := stats.Trend(stats.FilterByCategory(ownedExpenses(s, r), category), from, to, g, s.store.Settings())
+	writeJSON(w, http.StatusOK, map[string]any{
+		"category":    category,
+		"granularity": g,
+		"from":        from.Format("2006-01-02"),
+		"to":          to.Format("2006-01-02"),
+		"trend":       points,
+	})
+}
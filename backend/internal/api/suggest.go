@@ -0,0 +1,76 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// suggestion is a single autocomplete candidate: a category, merchant, or
+// note text seen on a past expense.
+type suggestion struct {
+	Value string `json:"value"`
+	Kind  string `json:"kind"`
+	count int
+	last  time.Time
+}
+
+// handleSuggest returns categories, merchants, and recent notes matching q
+// (a case-insensitive substring match), ranked by frequency then recency,
+// for a unified quick-entry autocomplete box. An empty q returns the
+// overall most-used values.
+func (s *Server) handleSuggest(w http.ResponseWriter, r *http.Request) {
+	if !requireKnownQueryParams(w, r, "q", "limit") {
+		return
+	}
+	q := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("q")))
+	limit, err := intParam(r, "limit", 10)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid limit")
+		return
+	}
+
+	seen := make(map[string]*suggestion)
+	bump := func(value, kind string, at time.Time) {
+		if value == "" {
+			return
+		}
+		key := kind + ":" + strings.ToLower(value)
+		sug, ok := seen[key]
+		if !ok {
+			sug = &suggestion{Value: value, Kind: kind}
+			seen[key] = sug
+		}
+		sug.count++
+		if at.After(sug.last) {
+			sug.last = at
+		}
+	}
+	for _, c := range s.store.Categories() {
+		bump(c.Name, "category", time.Time{})
+	}
+	for _, e := range s.store.List() {
+		bump(e.Category, "category", e.Date.Time())
+		bump(e.Merchant, "merchant", e.Date.Time())
+		bump(e.Note, "note", e.Date.Time())
+	}
+
+	matches := make([]*suggestion, 0, len(seen))
+	for _, sug := range seen {
+		if q != "" && !strings.Contains(strings.ToLower(sug.Value), q) {
+			continue
+		}
+		matches = append(matches, sug)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].count != matches[j].count {
+			return matches[i].count > matches[j].count
+		}
+		return matches[i].last.After(matches[j].last)
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	writeJSON(w, http.StatusOK, matches)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// syncBlobMeta is a SyncBlob without its ciphertext, for listing what a
+// client has stored without shipping every payload just to diff revisions.
+type syncBlobMeta struct {
+	ID        string    `json:"id"`
+	Revision  int64     `json:"revision"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// handleListSyncBlobs returns the caller's blobs' metadata only, so a
+// client can compare (id, revision) pairs against its local state and only
+// fetch the ones that changed.
+func (s *Server) handleListSyncBlobs(w http.ResponseWriter, r *http.Request) {
+	blobs := s.store.ListSyncBlobs(userID(r))
+	meta := make([]syncBlobMeta, len(blobs))
+	for i, b := range blobs {
+		meta[i] = syncBlobMeta{ID: b.ID, Revision: b.Revision, UpdatedAt: b.UpdatedAt}
+	}
+	writeJSON(w, http.StatusOK, meta)
+}
+
+// handleGetSyncBlob returns the caller's blob, ciphertext included.
+func (s *Server) handleGetSyncBlob(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.store.GetSyncBlob(userID(r), r.PathValue("id"))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "sync blob not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, b)
+}
+
+type putSyncBlobRequest struct {
+	Ciphertext []byte `json:"ciphertext"`
+	Nonce      []byte `json:"nonce"`
+	// ExpectedRevision, if non-zero, must match the blob's current revision
+	// or the write is rejected with 409, so a client that pulled stale data
+	// can't silently overwrite another device's newer write.
+	ExpectedRevision int64 `json:"expected_revision"`
+}
+
+// handlePutSyncBlob upserts the caller's blob at the given ID. The server
+// never inspects Ciphertext or Nonce; it stores and returns them exactly as
+// given, so the client is free to use any end-to-end encryption scheme it
+// wants without the server needing to know which one.
+func (s *Server) handlePutSyncBlob(w http.ResponseWriter, r *http.Request) {
+	var req putSyncBlobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.Ciphertext) == 0 {
+		writeError(w, r, http.StatusBadRequest, "ciphertext is required")
+		return
+	}
+	b, err := s.store.PutSyncBlob(r.Context(), userID(r), r.PathValue("id"), req.Ciphertext, req.Nonce, req.ExpectedRevision)
+	if err != nil {
+		writeError(w, r, http.StatusConflict, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, b)
+}
+
+// handleDeleteSyncBlob removes the caller's blob, if present.
+func (s *Server) handleDeleteSyncBlob(w http.ResponseWriter, r *http.Request) {
+	if err := s.store.DeleteSyncBlob(r.Context(), userID(r), r.PathValue("id")); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete sync blob")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/budgets"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/webhooks"
+)
+
+// triggerLimit caps how many items a polling trigger returns per call,
+// matching the small page size Zapier/IFTTT polling triggers expect (they
+// poll frequently and only care about what's new since their last seen
+// ID).
+const triggerLimit = 50
+
+// triggerEvents maps a trigger's URL slug to the webhook event a REST hook
+// subscription to it should receive, so the same underlying delivery
+// mechanism (see internal/webhooks) backs both polling and push-style
+// automations.
+var triggerEvents = map[string]model.WebhookEvent{
+	"new-expense":     model.WebhookEventExpenseCreated,
+	"budget-exceeded": model.WebhookEventBudgetThresholdCrossed,
+}
+
+// handleTriggerNewExpense is a Zapier/IFTTT-style polling trigger: the
+// caller's most recent expenses, newest first, each already carrying a
+// unique "id" (model.Expense.ID) the polling client uses to dedupe against
+// items it's already seen.
+func (s *Server) handleTriggerNewExpense(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Expense
+	for _, e := range s.store.List() {
+		if e.UserID == uid {
+			owned = append(owned, e)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool { return owned[i].CreatedAt.After(owned[j].CreatedAt) })
+	if len(owned) > triggerLimit {
+		owned = owned[:triggerLimit]
+	}
+	writeJSON(w, http.StatusOK, owned)
+}
+
+// budgetExceededSample is one polling-trigger item for a budget currently
+// over its limit. ID combines the budget's ID with its current period so a
+// budget that goes over limit again next period is dedupe-distinct from
+// this period's occurrence, rather than only ever firing once per budget
+// forever.
+type budgetExceededSample struct {
+	ID          string        `json:"id"`
+	Budget      *model.Budget `json:"budget"`
+	PeriodStart time.Time     `json:"period_start"`
+	Spent       money.Money   `json:"spent"`
+	PercentUsed float64       `json:"percent_used"`
+}
+
+// handleTriggerBudgetExceeded is a Zapier/IFTTT-style polling trigger:
+// every budget currently at or over 100% of its limit for the period
+// containing now.
+func (s *Server) handleTriggerBudgetExceeded(w http.ResponseWriter, r *http.Request) {
+	uid := userID(r)
+	var owned []*model.Budget
+	for _, b := range s.store.ListBudgets(false) {
+		if b.UserID == uid {
+			owned = append(owned, b)
+		}
+	}
+	var expenses []*model.Expense
+	for _, e := range s.store.List() {
+		if e.UserID == uid {
+			expenses = append(expenses, e)
+		}
+	}
+	statuses := budgets.Compute(owned, expenses, s.store.Settings(), time.Now())
+	samples := make([]budgetExceededSample, 0, len(statuses))
+	for _, st := range statuses {
+		if st.PercentUsed < 100 {
+			continue
+		}
+		samples = append(samples, budgetExceededSample{
+			ID:          st.Budget.ID + "-" + st.PeriodStart.Format("2006-01-02"),
+			Budget:      st.Budget,
+			PeriodStart: st.PeriodStart,
+			Spent:       st.Spent,
+			PercentUsed: st.PercentUsed,
+		})
+	}
+	writeJSON(w, http.StatusOK, samples)
+}
+
+type subscribeTriggerRequest struct {
+	TargetURL string `json:"target_url"`
+}
+
+// handleSubscribeTrigger implements the REST Hooks subscribe half of the
+// Zapier/IFTTT integration contract: registering target_url is just
+// registering a Webhook (see internal/api/webhooks.go) scoped to this
+// trigger's single event, so an unsubscribe later removes exactly the
+// automations this trigger created.
+func (s *Server) handleSubscribeTrigger(w http.ResponseWriter, r *http.Request) {
+	event, ok := triggerEvents[r.PathValue("trigger")]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "unknown trigger")
+		return
+	}
+	var req subscribeTriggerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.TargetURL == "" {
+		writeError(w, r, http.StatusBadRequest, "target_url is required")
+		return
+	}
+	if err := webhooks.ValidateURL(req.TargetURL); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid target_url: "+err.Error())
+		return
+	}
+	hook := &model.Webhook{
+		ID:        idgen.New(),
+		URL:       req.TargetURL,
+		Events:    []model.WebhookEvent{event},
+		Secret:    idgen.New(),
+		Active:    true,
+		CreatedAt: time.Now(),
+		UserID:    userID(r),
+	}
+	if err := s.store.AddWebhook(r.Context(), hook); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save subscription")
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"id": hook.ID})
+}
+
+// handleUnsubscribeTrigger implements the REST Hooks unsubscribe half: it
+// only removes the subscription if it's still registered for this
+// trigger's event, so a URL that dropped an ID via GET /api/triggers can't
+// be used to delete an unrelated webhook.
+func (s *Server) handleUnsubscribeTrigger(w http.ResponseWriter, r *http.Request) {
+	event, ok := triggerEvents[r.PathValue("trigger")]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "unknown trigger")
+		return
+	}
+	id := r.PathValue("id")
+	hook, ok := s.store.GetWebhook(id)
+	if !ok || hook.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "subscription not found")
+		return
+	}
+	subscribed := false
+	for _, e := range hook.Events {
+		if e == event {
+			subscribed = true
+			break
+		}
+	}
+	if !subscribed {
+		writeError(w, r, http.StatusNotFound, "subscription not found")
+		return
+	}
+	if err := s.store.DeleteWebhook(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to remove subscription")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
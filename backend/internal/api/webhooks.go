@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/webhooks"
+)
+
+// handleListWebhooks lists the caller's registered webhooks. Secret is
+// never included; see model.Webhook.
+func (s *Server) handleListWebhooks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.store.ListWebhooks(userID(r)))
+}
+
+type createWebhookRequest struct {
+	URL    string               `json:"url"`
+	Events []model.WebhookEvent `json:"events"`
+}
+
+// createWebhookResponse embeds the created webhook alongside the raw
+// signing secret, which (like a freshly created API key) is only ever
+// returned in this one response.
+type createWebhookResponse struct {
+	*model.Webhook
+	Secret string `json:"secret"`
+}
+
+func (s *Server) handleCreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req createWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.URL == "" {
+		writeError(w, r, http.StatusBadRequest, "url is required")
+		return
+	}
+	if err := webhooks.ValidateURL(req.URL); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid url: "+err.Error())
+		return
+	}
+	if len(req.Events) == 0 {
+		writeError(w, r, http.StatusBadRequest, "events must not be empty")
+		return
+	}
+	for _, e := range req.Events {
+		switch e {
+		case model.WebhookEventExpenseCreated, model.WebhookEventBudgetThresholdCrossed, model.WebhookEventRecurringBillDue:
+		default:
+			writeError(w, r, http.StatusBadRequest, "unknown event type: "+string(e))
+			return
+		}
+	}
+	secret := idgen.New()
+	hook := &model.Webhook{
+		ID:        idgen.New(),
+		URL:       req.URL,
+		Events:    req.Events,
+		Secret:    secret,
+		Active:    true,
+		CreatedAt: time.Now(),
+		UserID:    userID(r),
+	}
+	if err := s.store.AddWebhook(r.Context(), hook); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to save webhook")
+		return
+	}
+	writeJSON(w, http.StatusCreated, createWebhookResponse{Webhook: hook, Secret: secret})
+}
+
+func (s *Server) handleDeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	hook, ok := s.store.GetWebhook(id)
+	if !ok || hook.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "webhook not found")
+		return
+	}
+	if err := s.store.DeleteWebhook(r.Context(), id); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to delete webhook")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleListWebhookDeliveries returns the delivery log for one webhook,
+// most recent first, so a failing receiver can be diagnosed before
+// redelivering.
+func (s *Server) handleListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	hook, ok := s.store.GetWebhook(id)
+	if !ok || hook.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "webhook not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.store.ListWebhookDeliveries(id))
+}
+
+// handleRedeliverWebhook resends a previously recorded delivery's exact
+// payload under its original delivery ID, e.g. after fixing a receiver
+// that was down when the event first fired.
+func (s *Server) handleRedeliverWebhook(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	deliveryID := r.PathValue("deliveryId")
+	hook, ok := s.store.GetWebhook(id)
+	if !ok || hook.UserID != userID(r) {
+		writeError(w, r, http.StatusNotFound, "webhook not found")
+		return
+	}
+	delivery, ok := s.store.GetWebhookDelivery(id, deliveryID)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "delivery not found")
+		return
+	}
+	now := time.Now()
+	code, sendErr := webhooks.Deliver(hook, delivery.ID, now, delivery.Payload)
+	status := model.WebhookDeliverySucceeded
+	errMsg := ""
+	switch {
+	case sendErr != nil:
+		status = model.WebhookDeliveryFailed
+		errMsg = sendErr.Error()
+	case code < 200 || code >= 300:
+		status = model.WebhookDeliveryFailed
+		errMsg = fmt.Sprintf("receiver returned status %d", code)
+	}
+	updated, err := s.store.RecordWebhookRedelivery(r.Context(), id, deliveryID, status, code, errMsg, now)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "failed to record redelivery")
+		return
+	}
+	writeJSON(w, http.StatusOK, updated)
+}
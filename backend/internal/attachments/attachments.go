@@ -0,0 +1,53 @@
+// Package attachments stores uploaded receipt files on disk and enforces a
+// per-user storage quota, so the blob store doesn't grow unbounded. Only the
+// file bytes live here; the metadata record (owner, expense link, size) is
+// kept in internal/store alongside everything else.
+package attachments
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MaxBytesPerUser caps how much attachment storage a single user can
+// accumulate. It's a package constant rather than a Settings field since,
+// like other resource limits in this codebase (see maxAlertEvents,
+// maxSweepLogs), it protects the server rather than expressing a user
+// preference.
+const MaxBytesPerUser = 100 << 20 // 100MB
+
+// Save writes data to dir under id. The caller is responsible for
+// persisting the corresponding model.Attachment record; if that fails, it
+// should call Delete to avoid leaving an orphaned blob.
+func Save(dir, id string, data []byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create attachments dir: %w", err)
+	}
+	if err := os.WriteFile(path(dir, id), data, 0o644); err != nil {
+		return fmt.Errorf("write attachment: %w", err)
+	}
+	return nil
+}
+
+// Open returns the raw bytes previously saved under id.
+func Open(dir, id string) ([]byte, error) {
+	data, err := os.ReadFile(path(dir, id))
+	if err != nil {
+		return nil, fmt.Errorf("read attachment: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes the blob stored under id. It is not an error for the blob
+// to already be gone, so callers can call it unconditionally during cleanup.
+func Delete(dir, id string) error {
+	if err := os.Remove(path(dir, id)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("delete attachment: %w", err)
+	}
+	return nil
+}
+
+func path(dir, id string) string {
+	return filepath.Join(dir, id)
+}
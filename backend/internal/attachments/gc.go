@@ -0,0 +1,69 @@
+package attachments
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// RunGC periodically sweeps for orphaned attachments: blobs on disk with no
+// matching metadata record (left behind by a crash between Save and the
+// store persist), and metadata records whose Expense has since been
+// deleted. It blocks until stop is closed, so callers should run it in
+// their own goroutine.
+func RunGC(st *store.Store, dir string, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, _, err := Sweep(st, dir); err != nil {
+				slog.Error("attachments: gc sweep failed", "error", err)
+			}
+		}
+	}
+}
+
+// Sweep runs one GC pass and returns how many blobs and metadata records it
+// removed.
+func Sweep(st *store.Store, dir string) (removedBlobs, removedRecords int, err error) {
+	all := st.ListAttachments()
+	known := make(map[string]bool, len(all))
+	for _, a := range all {
+		known[a.ID] = true
+	}
+
+	entries, readErr := os.ReadDir(dir)
+	if readErr != nil && !os.IsNotExist(readErr) {
+		return 0, 0, readErr
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || known[entry.Name()] {
+			continue
+		}
+		if err := os.Remove(path(dir, entry.Name())); err == nil {
+			removedBlobs++
+		}
+	}
+
+	for _, a := range all {
+		if _, ok := st.Get(a.ExpenseID); ok {
+			continue
+		}
+		if err := Delete(dir, a.ID); err != nil {
+			slog.Error("attachments: gc failed to delete orphaned blob", "attachment_id", a.ID, "error", err)
+			continue
+		}
+		if err := st.DeleteAttachment(context.Background(), a.ID); err != nil {
+			slog.Error("attachments: gc failed to delete orphaned record", "attachment_id", a.ID, "error", err)
+			continue
+		}
+		removedRecords++
+	}
+	return removedBlobs, removedRecords, nil
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// erasureTokenTTL is how long a confirmation token for account erasure
+// stays valid, giving a user a narrow window to confirm without leaving a
+// long-lived way to delete an account if the token leaks.
+const erasureTokenTTL = 15 * time.Minute
+
+// ErasureTokens issues and checks single-use confirmation tokens for the
+// "delete my account" flow, so a bare DELETE request can't erase an
+// account outright.
+type ErasureTokens struct {
+	mu      sync.Mutex
+	byToken map[string]erasureGrant
+}
+
+type erasureGrant struct {
+	userID  string
+	expires time.Time
+}
+
+// NewErasureTokens returns an empty confirmation token tracker.
+func NewErasureTokens() *ErasureTokens {
+	return &ErasureTokens{byToken: make(map[string]erasureGrant)}
+}
+
+// Issue mints a confirmation token for userID.
+func (e *ErasureTokens) Issue(userID string) (string, error) {
+	token, err := NewToken()
+	if err != nil {
+		return "", err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.byToken[token] = erasureGrant{userID: userID, expires: time.Now().Add(erasureTokenTTL)}
+	return token, nil
+}
+
+// Consume validates that token confirms userID's own erasure request, and
+// invalidates it either way so it can't be replayed.
+func (e *ErasureTokens) Consume(userID, token string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	grant, ok := e.byToken[token]
+	delete(e.byToken, token)
+	return ok && grant.userID == userID && time.Now().Before(grant.expires)
+}
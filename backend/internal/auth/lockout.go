@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// lockoutThreshold is how many consecutive failed logins are allowed before
+// an account starts being locked out.
+const lockoutThreshold = 5
+
+// baseLockout is the lockout duration applied at the threshold; it doubles
+// with each failed attempt beyond that, up to maxLockout.
+const baseLockout = 30 * time.Second
+
+// maxLockout caps the exponential backoff so a very persistent attacker
+// (or a forgetful legitimate user) doesn't lock an account out forever.
+const maxLockout = 30 * time.Minute
+
+// Lockouts tracks failed login attempts per account (keyed by email) and
+// enforces an exponential backoff once too many accumulate.
+type Lockouts struct {
+	mu    sync.Mutex
+	byKey map[string]*attemptState
+}
+
+type attemptState struct {
+	failures    int
+	lockedUntil time.Time
+}
+
+// NewLockouts returns an empty lockout tracker.
+func NewLockouts() *Lockouts {
+	return &Lockouts{byKey: make(map[string]*attemptState)}
+}
+
+// Locked reports whether key is currently locked out, and until when.
+func (l *Lockouts) Locked(key string) (time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	state, ok := l.byKey[key]
+	if !ok || time.Now().After(state.lockedUntil) {
+		return time.Time{}, false
+	}
+	return state.lockedUntil, true
+}
+
+// RecordFailure registers a failed attempt for key and returns the lockout
+// duration applied, if the threshold was reached (zero otherwise).
+func (l *Lockouts) RecordFailure(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	state, ok := l.byKey[key]
+	if !ok {
+		state = &attemptState{}
+		l.byKey[key] = state
+	}
+	state.failures++
+	if state.failures < lockoutThreshold {
+		return 0
+	}
+	lockout := baseLockout << (state.failures - lockoutThreshold)
+	if lockout > maxLockout || lockout <= 0 {
+		lockout = maxLockout
+	}
+	state.lockedUntil = time.Now().Add(lockout)
+	return lockout
+}
+
+// Reset clears the failure count for key, e.g. after a successful login.
+func (l *Lockouts) Reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.byKey, key)
+}
@@ -0,0 +1,30 @@
+package auth
+
+import "testing"
+
+func TestLockoutsLocksAfterThreshold(t *testing.T) {
+	l := NewLockouts()
+	var lockout int
+	for i := 0; i < lockoutThreshold; i++ {
+		if d := l.RecordFailure("user@example.com"); d > 0 {
+			lockout++
+		}
+	}
+	if lockout != 1 {
+		t.Fatalf("expected exactly one lockout to trigger at the threshold, got %d", lockout)
+	}
+	if _, locked := l.Locked("user@example.com"); !locked {
+		t.Fatal("expected account to be locked after reaching the threshold")
+	}
+}
+
+func TestLockoutsResetClearsFailures(t *testing.T) {
+	l := NewLockouts()
+	for i := 0; i < lockoutThreshold; i++ {
+		l.RecordFailure("user@example.com")
+	}
+	l.Reset("user@example.com")
+	if _, locked := l.Locked("user@example.com"); locked {
+		t.Fatal("expected Reset to clear the lockout")
+	}
+}
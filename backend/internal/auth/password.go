@@ -0,0 +1,134 @@
+// Package auth handles password storage and session tokens for user
+// accounts.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// pbkdf2Iterations is the work factor for password hashing. The repo has no
+// external dependencies, so bcrypt/scrypt aren't available; PBKDF2-HMAC-SHA256
+// built from stdlib primitives gives the same salted, slow-to-brute-force
+// property.
+const pbkdf2Iterations = 100_000
+
+const saltSize = 16
+
+// HashPassword derives a salted PBKDF2 hash of password, encoded as
+// "iterations:salt:hash" so the parameters travel with the hash.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generate salt: %w", err)
+	}
+	derived := pbkdf2(password, salt, pbkdf2Iterations)
+	return fmt.Sprintf("%d:%s:%s", pbkdf2Iterations, hex.EncodeToString(salt), hex.EncodeToString(derived)), nil
+}
+
+// VerifyPassword reports whether password matches the encoded hash produced
+// by HashPassword.
+func VerifyPassword(password, encoded string) bool {
+	parts := splitHash(encoded)
+	if len(parts) != 3 {
+		return false
+	}
+	iterations := atoi(parts[0])
+	saltHex, hashHex := parts[1], parts[2]
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return false
+	}
+	want, err := hex.DecodeString(hashHex)
+	if err != nil {
+		return false
+	}
+	got := pbkdf2(password, salt, iterations)
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
+
+func splitHash(encoded string) []string {
+	var parts []string
+	start := 0
+	for i, c := range encoded {
+		if c == ':' {
+			parts = append(parts, encoded[start:i])
+			start = i + 1
+			if len(parts) == 2 {
+				parts = append(parts, encoded[start:])
+				return parts
+			}
+		}
+	}
+	return parts
+}
+
+func atoi(s string) int {
+	n := 0
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n
+}
+
+// pbkdf2 derives a 32-byte key from password and salt using HMAC-SHA256,
+// per RFC 8018.
+func pbkdf2(password string, salt []byte, iterations int) []byte {
+	mac := hmac.New(sha256.New, []byte(password))
+	mac.Write(salt)
+	mac.Write([]byte{0, 0, 0, 1})
+	u := mac.Sum(nil)
+	result := make([]byte, len(u))
+	copy(result, u)
+	for i := 1; i < iterations; i++ {
+		mac.Reset()
+		mac.Write(u)
+		u = mac.Sum(nil)
+		for j := range result {
+			result[j] ^= u[j]
+		}
+	}
+	return result
+}
+
+// NewToken returns a random, URL-safe session token.
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// apiKeyPrefix marks a token as an API key rather than a session token, so
+// it's recognizable in logs and support requests without exposing the key
+// itself.
+const apiKeyPrefix = "bak_"
+
+// NewAPIKey returns a new random API key and the hash that should be stored
+// for it. The raw key is only returned here; callers must show it to the
+// user once and never persist it directly.
+func NewAPIKey() (raw, hash string, err error) {
+	token, err := NewToken()
+	if err != nil {
+		return "", "", err
+	}
+	raw = apiKeyPrefix + token
+	return raw, HashAPIKey(raw), nil
+}
+
+// HashAPIKey returns the stored form of a raw API key. Unlike passwords, API
+// keys are high-entropy random tokens, so a fast, unsalted hash is enough to
+// avoid persisting the bearer secret in plaintext.
+func HashAPIKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
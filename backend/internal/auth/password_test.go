@@ -0,0 +1,22 @@
+package auth
+
+import "testing"
+
+func TestHashAndVerifyPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	if !VerifyPassword("correct horse battery staple", hash) {
+		t.Fatal("VerifyPassword rejected the correct password")
+	}
+	if VerifyPassword("wrong password", hash) {
+		t.Fatal("VerifyPassword accepted an incorrect password")
+	}
+}
+
+func TestVerifyPasswordRejectsMalformedHash(t *testing.T) {
+	if VerifyPassword("anything", "not-a-valid-hash") {
+		t.Fatal("VerifyPassword accepted a malformed hash")
+	}
+}
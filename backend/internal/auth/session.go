@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+)
+
+// sessionTTL is how long a session cookie stays valid after login.
+const sessionTTL = 30 * 24 * time.Hour
+
+// Sessions tracks logged-in sessions in memory. Sessions aren't persisted to
+// disk, so restarting the server signs everyone out; that's an acceptable
+// trade-off for a token that's meant to be short-lived and easy to revoke.
+type Sessions struct {
+	mu      sync.RWMutex
+	byToken map[string]session
+}
+
+type session struct {
+	id        string
+	userID    string
+	userAgent string
+	createdAt time.Time
+	lastSeen  time.Time
+	expires   time.Time
+}
+
+// Info is the caller-facing view of a session, safe to return from an API
+// response since it never carries the bearer token itself.
+type Info struct {
+	ID        string
+	UserAgent string
+	CreatedAt time.Time
+	LastSeen  time.Time
+}
+
+// NewSessions returns an empty session store.
+func NewSessions() *Sessions {
+	return &Sessions{byToken: make(map[string]session)}
+}
+
+// Create mints a new session for userID and returns its token. userAgent is
+// recorded for display in the "active sessions" list so a user can tell
+// their devices apart.
+func (s *Sessions) Create(userID, userAgent string) (string, error) {
+	token, err := NewToken()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byToken[token] = session{
+		id:        idgen.New(),
+		userID:    userID,
+		userAgent: userAgent,
+		createdAt: now,
+		lastSeen:  now,
+		expires:   now.Add(sessionTTL),
+	}
+	return token, nil
+}
+
+// UserID returns the user ID associated with token, if it exists and hasn't
+// expired, and records this as the session's most recent activity.
+func (s *Sessions) UserID(token string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.byToken[token]
+	if !ok || time.Now().After(sess.expires) {
+		return "", false
+	}
+	sess.lastSeen = time.Now()
+	s.byToken[token] = sess
+	return sess.userID, true
+}
+
+// Revoke invalidates token, e.g. on logout.
+func (s *Sessions) Revoke(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.byToken, token)
+}
+
+// List returns the active, unexpired sessions belonging to userID, most
+// recently active first.
+func (s *Sessions) List(userID string) []Info {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	now := time.Now()
+	var infos []Info
+	for _, sess := range s.byToken {
+		if sess.userID != userID || now.After(sess.expires) {
+			continue
+		}
+		infos = append(infos, Info{
+			ID:        sess.id,
+			UserAgent: sess.userAgent,
+			CreatedAt: sess.createdAt,
+			LastSeen:  sess.lastSeen,
+		})
+	}
+	for i := 1; i < len(infos); i++ {
+		for j := i; j > 0 && infos[j].LastSeen.After(infos[j-1].LastSeen); j-- {
+			infos[j], infos[j-1] = infos[j-1], infos[j]
+		}
+	}
+	return infos
+}
+
+// RevokeByID invalidates the session identified by id, but only if it
+// belongs to userID, so one user can't revoke another's session by guessing
+// its ID. It reports whether a matching session was found.
+func (s *Sessions) RevokeByID(userID, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token, sess := range s.byToken {
+		if sess.id == id && sess.userID == userID {
+			delete(s.byToken, token)
+			return true
+		}
+	}
+	return false
+}
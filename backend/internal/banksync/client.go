@@ -0,0 +1,83 @@
+// Package banksync pulls transactions from a Plaid-compatible bank
+// aggregation API and converts them to expenses.
+package banksync
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Client talks to a Plaid-compatible /transactions/sync endpoint.
+type Client struct {
+	BaseURL    string
+	ClientID   string
+	Secret     string
+	HTTPClient *http.Client
+}
+
+// NewClient returns a Client for baseURL, defaulting to Plaid's production
+// endpoint shape when baseURL is empty.
+func NewClient(baseURL, clientID, secret string) *Client {
+	if baseURL == "" {
+		baseURL = "https://production.plaid.com"
+	}
+	return &Client{BaseURL: baseURL, ClientID: clientID, Secret: secret, HTTPClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Transaction is the subset of a Plaid transaction object this package
+// uses.
+type Transaction struct {
+	TransactionID string   `json:"transaction_id"`
+	Amount        float64  `json:"amount"`
+	Date          string   `json:"date"`
+	Name          string   `json:"name"`
+	Category      []string `json:"category"`
+	Pending       bool     `json:"pending"`
+}
+
+type syncRequest struct {
+	ClientID    string `json:"client_id"`
+	Secret      string `json:"secret"`
+	AccessToken string `json:"access_token"`
+	Cursor      string `json:"cursor,omitempty"`
+}
+
+type syncResponse struct {
+	Added      []Transaction `json:"added"`
+	NextCursor string        `json:"next_cursor"`
+	HasMore    bool          `json:"has_more"`
+}
+
+// Sync fetches every transaction added since cursor, following has_more
+// pages, and returns them alongside the cursor to persist for next time.
+func (c *Client) Sync(accessToken, cursor string) ([]Transaction, string, error) {
+	var all []Transaction
+	for {
+		reqBody, err := json.Marshal(syncRequest{ClientID: c.ClientID, Secret: c.Secret, AccessToken: accessToken, Cursor: cursor})
+		if err != nil {
+			return nil, cursor, err
+		}
+		resp, err := c.HTTPClient.Post(c.BaseURL+"/transactions/sync", "application/json", bytes.NewReader(reqBody))
+		if err != nil {
+			return nil, cursor, err
+		}
+		var body syncResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, cursor, fmt.Errorf("decode sync response: %w", err)
+		}
+		if resp.StatusCode >= 300 {
+			return nil, cursor, fmt.Errorf("transactions/sync returned status %d", resp.StatusCode)
+		}
+		all = append(all, body.Added...)
+		cursor = body.NextCursor
+		if !body.HasMore {
+			break
+		}
+	}
+	return all, cursor, nil
+}
@@ -0,0 +1,38 @@
+package banksync
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// RunScheduler syncs every active linked account once per interval. It
+// blocks until stop is closed, so callers should run it in its own
+// goroutine.
+func RunScheduler(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			syncAll(st)
+		}
+	}
+}
+
+func syncAll(st *store.Store) {
+	s := st.Settings()
+	if s.PlaidClientID == "" {
+		return
+	}
+	c := NewClient(s.PlaidBaseURL, s.PlaidClientID, s.PlaidSecret)
+	for _, account := range st.ListLinkedAccounts(false) {
+		if err := SyncAccount(context.Background(), st, c, account); err != nil {
+			slog.Error("banksync: sync account failed", "account_id", account.ID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package banksync
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/importer"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// SyncAccount pulls new transactions for account since its last sync
+// cursor, converts positive-amount transactions to expenses (Plaid reports
+// outflows as positive amounts), and adds any that aren't already present.
+// It updates and persists account's cursor and LastSyncedAt regardless of
+// whether new expenses were found.
+func SyncAccount(ctx context.Context, st *store.Store, c *Client, account *model.LinkedAccount) error {
+	transactions, nextCursor, err := c.Sync(account.AccessToken, account.Cursor)
+	if err != nil {
+		return fmt.Errorf("sync account %s: %w", account.ID, err)
+	}
+
+	existing := st.List()
+	var newExpenses []*model.Expense
+	for _, txn := range transactions {
+		if txn.Pending || txn.Amount <= 0 {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", txn.Date)
+		if err != nil {
+			slog.Error("banksync: skipping transaction with unparsable date", "transaction_id", txn.TransactionID, "date", txn.Date, "error", err)
+			continue
+		}
+		e := &model.Expense{
+			ID:         idgen.New(),
+			ExternalID: txn.TransactionID,
+			Amount:     money.FromFloat(txn.Amount),
+			Merchant:   txn.Name,
+			Category:   category(txn),
+			Date:       civildate.FromTime(date),
+			CreatedAt:  time.Now(),
+		}
+		if importer.IsDuplicate(existing, e) {
+			continue
+		}
+		newExpenses = append(newExpenses, e)
+		existing = append(existing, e)
+	}
+
+	// A sync batch can bring in many transactions at once; apply them with a
+	// single AddBatch call so the data file is rewritten once per sync, not
+	// once per transaction.
+	if len(newExpenses) > 0 {
+		if _, err := st.AddBatch(ctx, newExpenses); err != nil {
+			return fmt.Errorf("save synced expenses for account %s: %w", account.ID, err)
+		}
+	}
+
+	account.Cursor = nextCursor
+	account.LastSyncedAt = time.Now()
+	return st.AddLinkedAccount(ctx, account)
+}
+
+func category(txn Transaction) string {
+	if len(txn.Category) == 0 {
+		return "uncategorized"
+	}
+	return txn.Category[0]
+}
@@ -0,0 +1,57 @@
+package banksync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+func TestSyncAccountAddsNewExpensesAndAdvancesCursor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req syncRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Cursor != "" {
+			_ = json.NewEncoder(w).Encode(syncResponse{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(syncResponse{
+			Added: []Transaction{
+				{TransactionID: "txn-1", Amount: 42.5, Date: "2026-03-01", Name: "Coffee Shop", Category: []string{"Food and Drink"}},
+				{TransactionID: "txn-2", Amount: -10, Date: "2026-03-02", Name: "Refund"},
+			},
+			NextCursor: "cursor-1",
+		})
+	}))
+	defer srv.Close()
+
+	st, err := store.New(filepath.Join(t.TempDir(), "data.json"), nil)
+	if err != nil {
+		t.Fatalf("store.New: %v", err)
+	}
+	account := &model.LinkedAccount{ID: "acct-1", AccessToken: "tok", Active: true}
+	c := NewClient(srv.URL, "client-id", "secret")
+
+	if err := SyncAccount(context.Background(), st, c, account); err != nil {
+		t.Fatalf("SyncAccount: %v", err)
+	}
+
+	expenses := st.List()
+	if len(expenses) != 1 {
+		t.Fatalf("got %d expenses, want 1 (negative-amount transaction should be skipped)", len(expenses))
+	}
+	if expenses[0].ExternalID != "txn-1" || expenses[0].Category != "Food and Drink" {
+		t.Errorf("got %+v, want txn-1 categorized as Food and Drink", expenses[0])
+	}
+	if account.Cursor != "cursor-1" {
+		t.Errorf("got cursor %q, want cursor-1", account.Cursor)
+	}
+	if account.LastSyncedAt.IsZero() {
+		t.Error("expected LastSyncedAt to be set")
+	}
+}
@@ -0,0 +1,160 @@
+// Package budgets computes spend-vs-limit status for user-defined
+// per-category budgets.
+package budgets
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/recurring"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// Pace describes how a budget's spend-to-date compares to how far through
+// the period it is.
+type Pace string
+
+const (
+	// PaceOnTrack means spend-to-date is at or below the elapsed fraction
+	// of the period.
+	PaceOnTrack Pace = "on_track"
+	// PaceAtRisk means spend-to-date is ahead of the elapsed fraction but
+	// hasn't exceeded the limit yet.
+	PaceAtRisk Pace = "at_risk"
+	// PaceOverBudget means spend-to-date has reached or exceeded the limit.
+	PaceOverBudget Pace = "over_budget"
+)
+
+// Status is a budget's spend-to-date compared against its limit for the
+// period currently in progress.
+type Status struct {
+	Budget      *model.Budget `json:"budget"`
+	PeriodStart time.Time     `json:"period_start"`
+	PeriodEnd   time.Time     `json:"period_end"`
+	Spent       money.Money   `json:"spent"`
+	Remaining   money.Money   `json:"remaining"`
+	// PercentUsed is spent as a percentage of the budget's limit (can exceed
+	// 100 once over budget), or 0 for a limit that isn't positive.
+	PercentUsed float64 `json:"percent_used"`
+	Pace        Pace    `json:"pace"`
+}
+
+// Compute returns the status of every budget for the period containing now.
+func Compute(budgetList []*model.Budget, expenses []*model.Expense, s settings.Settings, now time.Time) []Status {
+	statuses := make([]Status, 0, len(budgetList))
+	for _, b := range budgetList {
+		g := period.Granularity(b.Period)
+		if b.Period == "" {
+			g = period.Month
+		}
+		periodStart := period.Start(now, g, s)
+		periodEnd := period.Next(periodStart, g)
+
+		var spent money.Money
+		for _, e := range expenses {
+			if e.Category == b.Category && !e.Date.Time().Before(periodStart) && e.Date.Time().Before(periodEnd) {
+				spent += e.Amount
+			}
+		}
+		var percentUsed float64
+		if b.Limit > 0 {
+			percentUsed = spent.Float64() / b.Limit.Float64() * 100
+		}
+		statuses = append(statuses, Status{
+			Budget:      b,
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			Spent:       spent,
+			Remaining:   b.Limit - spent,
+			PercentUsed: percentUsed,
+			Pace:        pace(b.Limit, spent, periodStart, periodEnd, now),
+		})
+	}
+	return statuses
+}
+
+// SafeToSpend is the overall monthly budget headline: what's left of the
+// budget for the current month once spend-to-date and every bill projected
+// to land before month end are accounted for.
+type SafeToSpend struct {
+	Budget        money.Money `json:"budget"`
+	SpentToDate   money.Money `json:"spent_to_date"`
+	UpcomingBills money.Money `json:"upcoming_bills"`
+	SafeToSpend   money.Money `json:"safe_to_spend"`
+	PeriodStart   time.Time   `json:"period_start"`
+	PeriodEnd     time.Time   `json:"period_end"`
+}
+
+// ComputeSafeToSpend subtracts spend-to-date and the amount of every bill
+// due before month end from the overall monthly budget.
+func ComputeSafeToSpend(budget money.Money, expenses []*model.Expense, patterns []*model.RecurringPattern, s settings.Settings, now time.Time) SafeToSpend {
+	periodStart := period.Start(now, period.Month, s)
+	periodEnd := period.Next(periodStart, period.Month)
+
+	var spent money.Money
+	for _, e := range expenses {
+		if !e.Date.Time().Before(periodStart) && e.Date.Time().Before(periodEnd) {
+			spent += e.Amount
+		}
+	}
+
+	var upcoming money.Money
+	for _, occ := range recurring.Upcoming(patterns, now, periodEnd) {
+		upcoming += occ.Pattern.Amount
+	}
+
+	return SafeToSpend{
+		Budget:        budget,
+		SpentToDate:   spent,
+		UpcomingBills: upcoming,
+		SafeToSpend:   budget - spent - upcoming,
+		PeriodStart:   periodStart,
+		PeriodEnd:     periodEnd,
+	}
+}
+
+// ProjectedExhaustion estimates the date a budget's spend, extrapolated at
+// its current daily rate, will reach its limit, for surfacing "your dining
+// budget will run out around the 24th" warnings ahead of time. ok is false
+// if the budget has no positive limit, nothing has been spent yet, or the
+// current rate isn't on pace to exhaust it before the period ends.
+func ProjectedExhaustion(st Status, now time.Time) (date time.Time, ok bool) {
+	if st.Budget.Limit <= 0 || st.Spent <= 0 {
+		return time.Time{}, false
+	}
+	if st.Spent >= st.Budget.Limit {
+		return now, true
+	}
+	elapsed := now.Sub(st.PeriodStart)
+	if elapsed <= 0 {
+		return time.Time{}, false
+	}
+	dailyRate := st.Spent.Float64() / elapsed.Hours() * 24
+	if dailyRate <= 0 {
+		return time.Time{}, false
+	}
+	daysToExhaust := st.Remaining.Float64() / dailyRate
+	projected := now.Add(time.Duration(daysToExhaust * float64(24*time.Hour)))
+	if projected.After(st.PeriodEnd) {
+		return time.Time{}, false
+	}
+	return projected, true
+}
+
+func pace(limit, spent money.Money, periodStart, periodEnd, now time.Time) Pace {
+	if limit > 0 && spent >= limit {
+		return PaceOverBudget
+	}
+	total := periodEnd.Sub(periodStart)
+	if total <= 0 || limit <= 0 {
+		return PaceOnTrack
+	}
+	elapsed := now.Sub(periodStart)
+	expected := limit.Float64() * (float64(elapsed) / float64(total))
+	if spent.Float64() > expected {
+		return PaceAtRisk
+	}
+	return PaceOnTrack
+}
@@ -0,0 +1,71 @@
+package budgets
+
+import (
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+func TestComputeSafeToSpendSubtractsSpendAndUpcomingBills(t *testing.T) {
+	s := settings.Default()
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	expenses := []*model.Expense{
+		{Category: "food", Amount: 200, Date: civildate.New(2026, time.March, 5)},
+		{Category: "food", Amount: 999, Date: civildate.New(2026, time.February, 20)},
+	}
+	patterns := []*model.RecurringPattern{
+		{ID: "p1", Amount: 100, Frequency: model.FrequencyMonthly, StartDate: time.Date(2026, 3, 25, 0, 0, 0, 0, time.UTC), Active: true},
+	}
+
+	result := ComputeSafeToSpend(1000, expenses, patterns, s, now)
+	if result.SpentToDate != 200 {
+		t.Errorf("SpentToDate = %v, want 200", result.SpentToDate)
+	}
+	if result.UpcomingBills != 100 {
+		t.Errorf("UpcomingBills = %v, want 100", result.UpcomingBills)
+	}
+	if result.SafeToSpend != 700 {
+		t.Errorf("SafeToSpend = %v, want 700", result.SafeToSpend)
+	}
+}
+
+func TestComputeTracksSpendAgainstLimitWithinPeriod(t *testing.T) {
+	s := settings.Default()
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	b := &model.Budget{ID: "b1", Category: "food", Limit: 200, Period: "month", Active: true}
+	expenses := []*model.Expense{
+		{Category: "food", Amount: 50, Date: civildate.New(2026, time.March, 1)},
+		{Category: "food", Amount: 30, Date: civildate.New(2026, time.March, 10)},
+		{Category: "food", Amount: 20, Date: civildate.New(2026, time.February, 28)},
+		{Category: "transport", Amount: 999, Date: civildate.New(2026, time.March, 5)},
+	}
+
+	statuses := Compute([]*model.Budget{b}, expenses, s, now)
+	if len(statuses) != 1 {
+		t.Fatalf("got %d statuses, want 1", len(statuses))
+	}
+	st := statuses[0]
+	if st.Spent != 80 {
+		t.Errorf("Spent = %v, want 80 (February expense and other category excluded)", st.Spent)
+	}
+	if st.Remaining != 120 {
+		t.Errorf("Remaining = %v, want 120", st.Remaining)
+	}
+}
+
+func TestComputePaceOverBudgetOnceLimitReached(t *testing.T) {
+	s := settings.Default()
+	now := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	b := &model.Budget{ID: "b1", Category: "food", Limit: 100, Period: "month", Active: true}
+	expenses := []*model.Expense{
+		{Category: "food", Amount: 150, Date: civildate.New(2026, time.March, 1)},
+	}
+
+	statuses := Compute([]*model.Budget{b}, expenses, s, now)
+	if statuses[0].Pace != PaceOverBudget {
+		t.Errorf("Pace = %v, want %v", statuses[0].Pace, PaceOverBudget)
+	}
+}
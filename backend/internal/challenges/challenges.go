@@ -0,0 +1,86 @@
+// Package challenges evaluates user-defined savings challenges against
+// recorded expenses, so progress updates automatically instead of
+// requiring the user to log it by hand.
+package challenges
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Status is a challenge's automatically evaluated progress as of now.
+type Status struct {
+	Challenge *model.Challenge `json:"challenge"`
+	Spent     money.Money      `json:"spent"`
+	// Remaining is TargetAmount minus Spent, for ChallengeSpendLimit.
+	// Zero for ChallengeNoSpend.
+	Remaining money.Money `json:"remaining,omitempty"`
+	// PercentElapsed is how far through [StartDate, EndDate] now is,
+	// clamped to [0, 100].
+	PercentElapsed float64 `json:"percent_elapsed"`
+	// OnTrack is true if the rule hasn't yet been broken: no spend so far
+	// for ChallengeNoSpend, or Spent under TargetAmount for
+	// ChallengeSpendLimit.
+	OnTrack bool `json:"on_track"`
+	// Complete is true once EndDate has passed.
+	Complete bool `json:"complete"`
+}
+
+// Compute evaluates every challenge in list against expenses as of now.
+func Compute(list []*model.Challenge, expenses []*model.Expense, now time.Time) []Status {
+	statuses := make([]Status, 0, len(list))
+	for _, c := range list {
+		statuses = append(statuses, computeOne(c, expenses, now))
+	}
+	return statuses
+}
+
+func computeOne(c *model.Challenge, expenses []*model.Expense, now time.Time) Status {
+	var spent money.Money
+	for _, e := range expenses {
+		if e.Date.Time().Before(c.StartDate) || e.Date.Time().After(c.EndDate) {
+			continue
+		}
+		if c.Category != "" && e.Category != c.Category {
+			continue
+		}
+		spent += e.Amount
+	}
+
+	var percentElapsed float64
+	if total := c.EndDate.Sub(c.StartDate); total > 0 {
+		percentElapsed = clamp01(float64(now.Sub(c.StartDate))/float64(total)) * 100
+	}
+
+	var onTrack bool
+	var remaining money.Money
+	switch c.Type {
+	case model.ChallengeSpendLimit:
+		remaining = c.TargetAmount - spent
+		onTrack = spent <= c.TargetAmount
+	default: // ChallengeNoSpend
+		onTrack = spent == 0
+	}
+
+	return Status{
+		Challenge:      c,
+		Spent:          spent,
+		Remaining:      remaining,
+		PercentElapsed: percentElapsed,
+		OnTrack:        onTrack,
+		Complete:       !now.Before(c.EndDate),
+	}
+}
+
+func clamp01(f float64) float64 {
+	switch {
+	case f < 0:
+		return 0
+	case f > 1:
+		return 1
+	default:
+		return f
+	}
+}
@@ -0,0 +1,105 @@
+// Package civildate represents calendar dates (year, month, day) without a
+// time-of-day or timezone component, so a date entered by a user renders as
+// the same day everywhere it's displayed. Storing dates as midnight-UTC
+// time.Time and serializing them as RFC3339 (e.g. "2024-03-15T00:00:00Z")
+// looks fine on the server but renders as the previous day once a browser
+// west of UTC converts it to local time — that's the off-by-one-day bug
+// this package exists to remove at the source.
+package civildate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// wireFormat is the only shape a Date is ever written to JSON in.
+const wireFormat = "2006-01-02"
+
+// Date is a calendar date, stored as the number of days since the Unix
+// epoch (1970-01-01). The zero value is that epoch, matching how time.Time's
+// zero value is also a real (if unlikely) date rather than a sentinel; use
+// IsZero to test for "not set".
+type Date int32
+
+// New returns the Date for the given calendar day.
+func New(year int, month time.Month, day int) Date {
+	return FromTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// FromTime returns the calendar date t falls on in its own location,
+// discarding the time-of-day. Calendar day, not instant, is what a "date"
+// field means in this app, so this is preferred over stripping time-of-day
+// while leaving the timezone conversion to happen implicitly later.
+func FromTime(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// Today returns the current calendar date in the local timezone.
+func Today() Date {
+	return FromTime(time.Now())
+}
+
+// Time returns d as a time.Time at midnight UTC, for callers that need to
+// hand it to a time.Time-shaped API (formatting beyond the wire format,
+// arithmetic against instants, etc.).
+func (d Date) Time() time.Time {
+	return time.Unix(int64(d)*86400, 0).UTC()
+}
+
+// IsZero reports whether d is the Date zero value.
+func (d Date) IsZero() bool {
+	return d == 0
+}
+
+// AddDays returns the date n days after d (n may be negative).
+func (d Date) AddDays(n int) Date {
+	return d + Date(n)
+}
+
+// Before reports whether d falls before o.
+func (d Date) Before(o Date) bool {
+	return d < o
+}
+
+// After reports whether d falls after o.
+func (d Date) After(o Date) bool {
+	return d > o
+}
+
+// String renders d in wireFormat, e.g. "2024-03-15".
+func (d Date) String() string {
+	return d.Time().Format(wireFormat)
+}
+
+// MarshalJSON encodes d as a quoted "YYYY-MM-DD" string.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON accepts a quoted "YYYY-MM-DD" string, the format every Date
+// is written in from here on, or a quoted RFC3339 timestamp, so data files
+// written before this type existed keep loading without an explicit
+// migration step.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" || s == `""` {
+		*d = 0
+		return nil
+	}
+	unquoted, err := strconv.Unquote(s)
+	if err != nil {
+		return err
+	}
+	if t, err := time.Parse(wireFormat, unquoted); err == nil {
+		*d = FromTime(t)
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, unquoted)
+	if err != nil {
+		return err
+	}
+	*d = FromTime(t)
+	return nil
+}
@@ -0,0 +1,63 @@
+package civildate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJSONRoundTripsExactly(t *testing.T) {
+	d := New(2024, time.March, 15)
+	data, err := d.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if string(data) != `"2024-03-15"` {
+		t.Fatalf("got %s, want %q", data, "2024-03-15")
+	}
+	var got Date
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if got != d {
+		t.Fatalf("got %v, want %v", got, d)
+	}
+}
+
+func TestUnmarshalAcceptsLegacyRFC3339(t *testing.T) {
+	var d Date
+	if err := d.UnmarshalJSON([]byte(`"2024-03-15T00:00:00Z"`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if want := New(2024, time.March, 15); d != want {
+		t.Fatalf("got %v, want %v", d, want)
+	}
+}
+
+func TestFromTimeUsesTheGivenLocation(t *testing.T) {
+	// 11pm Pacific on March 15 is already March 16 in UTC; FromTime must use
+	// the timestamp's own location, not shift to UTC first, or this is the
+	// exact off-by-one-day bug the package exists to avoid.
+	loc := time.FixedZone("PT", -7*3600)
+	t15 := time.Date(2024, time.March, 15, 23, 0, 0, 0, loc)
+	if got := FromTime(t15); got != New(2024, time.March, 15) {
+		t.Fatalf("got %v, want 2024-03-15", got)
+	}
+}
+
+func TestBeforeAfter(t *testing.T) {
+	a := New(2024, time.March, 15)
+	b := New(2024, time.March, 16)
+	if !a.Before(b) || a.After(b) {
+		t.Fatalf("expected %v before %v", a, b)
+	}
+	if !b.After(a) || b.Before(a) {
+		t.Fatalf("expected %v after %v", b, a)
+	}
+}
+
+func TestAddDays(t *testing.T) {
+	d := New(2024, time.February, 28)
+	if got, want := d.AddDays(1), New(2024, time.February, 29); got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,261 @@
+// Package config resolves the server's runtime configuration from, in
+// increasing order of precedence: built-in defaults, an optional config
+// file, environment variables, and command-line flags.
+//
+// The repo has no external dependencies, so a full YAML/TOML parser isn't
+// available; the config file format is JSON, which stdlib parses without
+// pulling one in and which every YAML parser also accepts as valid input.
+package config
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Config holds every runtime-tunable setting for the server.
+type Config struct {
+	Port        string
+	BindAddress string
+	// SocketPath, if set, listens on a Unix domain socket at this path
+	// instead of a TCP port, for deployments that sit behind a reverse
+	// proxy on the same host and don't need a TCP port exposed at all. It
+	// is ignored when systemd socket activation is in effect.
+	SocketPath  string
+	DataFile    string
+	CORSOrigins []string
+	AuthMode    string
+	Timezone    string
+	// ReportsDir is where scheduled monthly reports (PDF/CSV) are saved.
+	ReportsDir string
+	// AttachmentsDir is where uploaded receipt attachments are saved. See
+	// internal/attachments.
+	AttachmentsDir string
+	// AttachmentScanClamAVAddr, if set, is a clamd socket every uploaded
+	// attachment is streamed through for a malware scan before it's
+	// persisted, e.g. "unix:///var/run/clamav/clamd.ctl". Empty skips the
+	// scan, since ClamAV is an optional dependency this repo doesn't
+	// bundle.
+	AttachmentScanClamAVAddr string
+	// AdminBindAddress, if set, moves /api/admin/* and /debug/pprof/* off the
+	// public listener onto a second one bound to this address (e.g.
+	// "127.0.0.1:8082" or a Unix socket path prefixed "unix:"), so those
+	// routes are unreachable from the public network even if the admin token
+	// check were somehow bypassed. Empty keeps them on the public listener,
+	// gated only by the admin token, as before.
+	AdminBindAddress string
+	SweepInterval    time.Duration
+}
+
+// Defaults returns the configuration used when nothing else overrides it.
+func Defaults() Config {
+	return Config{
+		Port:           "8081",
+		BindAddress:    "",
+		DataFile:       "data/expenses.db",
+		AuthMode:       "session",
+		Timezone:       "UTC",
+		ReportsDir:     "data/reports",
+		AttachmentsDir: "data/attachments",
+		SweepInterval:  time.Hour,
+	}
+}
+
+// Load resolves the Config from args (typically os.Args[1:]), the process
+// environment, and an optional config file. Flags win over environment
+// variables, which win over the config file, which wins over Defaults.
+func Load(args []string) (Config, error) {
+	cfg := Defaults()
+
+	fs := flag.NewFlagSet("budgetapp", flag.ContinueOnError)
+	configPath := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a JSON config file")
+	port := fs.String("port", "", "port to listen on")
+	bind := fs.String("bind", "", "address to bind to")
+	socketPath := fs.String("socket", "", "listen on this Unix domain socket instead of a TCP port")
+	dataFile := fs.String("data-file", "", "path to the data file")
+	reportsDir := fs.String("reports-dir", "", "directory where scheduled monthly reports are saved")
+	attachmentsDir := fs.String("attachments-dir", "", "directory where uploaded receipt attachments are saved")
+	attachmentScanClamAVAddr := fs.String("attachment-scan-clamav-addr", "", "clamd socket to scan uploaded attachments through, e.g. unix:///var/run/clamav/clamd.ctl")
+	adminBindAddress := fs.String("admin-bind-address", "", "bind /api/admin/* and /debug/pprof/* to a separate listener on this address instead of the public one")
+	corsOrigins := fs.String("cors-origins", "", "comma-separated list of allowed CORS origins")
+	authMode := fs.String("auth-mode", "", `authentication mode: "session" (default), "none" for local development, or "profile" for header-switched named profiles without sessions`)
+	timezone := fs.String("timezone", "", "IANA timezone for scheduled jobs and reports")
+	sweepInterval := fs.String("sweep-interval", "", "interval between background sweeps, e.g. 1h")
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	if *configPath != "" {
+		if err := cfg.mergeFile(*configPath); err != nil {
+			return Config{}, err
+		}
+	}
+
+	cfg.mergeEnv()
+
+	if *port != "" {
+		cfg.Port = *port
+	}
+	if *bind != "" {
+		cfg.BindAddress = *bind
+	}
+	if *socketPath != "" {
+		cfg.SocketPath = *socketPath
+	}
+	if *dataFile != "" {
+		cfg.DataFile = *dataFile
+	}
+	if *reportsDir != "" {
+		cfg.ReportsDir = *reportsDir
+	}
+	if *attachmentsDir != "" {
+		cfg.AttachmentsDir = *attachmentsDir
+	}
+	if *attachmentScanClamAVAddr != "" {
+		cfg.AttachmentScanClamAVAddr = *attachmentScanClamAVAddr
+	}
+	if *adminBindAddress != "" {
+		cfg.AdminBindAddress = *adminBindAddress
+	}
+	if *corsOrigins != "" {
+		cfg.CORSOrigins = splitCSV(*corsOrigins)
+	}
+	if *authMode != "" {
+		cfg.AuthMode = *authMode
+	}
+	if *timezone != "" {
+		cfg.Timezone = *timezone
+	}
+	if *sweepInterval != "" {
+		d, err := time.ParseDuration(*sweepInterval)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid -sweep-interval %q: %w", *sweepInterval, err)
+		}
+		cfg.SweepInterval = d
+	}
+
+	return cfg, nil
+}
+
+// fileConfig mirrors Config for JSON decoding; SweepInterval is a string
+// here (e.g. "1h") since encoding/json has no notion of time.Duration.
+type fileConfig struct {
+	Port                     string   `json:"port"`
+	BindAddress              string   `json:"bind_address"`
+	SocketPath               string   `json:"socket_path"`
+	DataFile                 string   `json:"data_file"`
+	CORSOrigins              []string `json:"cors_origins"`
+	AuthMode                 string   `json:"auth_mode"`
+	Timezone                 string   `json:"timezone"`
+	ReportsDir               string   `json:"reports_dir"`
+	AttachmentsDir           string   `json:"attachments_dir"`
+	AttachmentScanClamAVAddr string   `json:"attachment_scan_clamav_addr"`
+	AdminBindAddress         string   `json:"admin_bind_address"`
+	SweepInterval            string   `json:"sweep_interval"`
+}
+
+func (c *Config) mergeFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+	var fc fileConfig
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	if fc.Port != "" {
+		c.Port = fc.Port
+	}
+	if fc.BindAddress != "" {
+		c.BindAddress = fc.BindAddress
+	}
+	if fc.SocketPath != "" {
+		c.SocketPath = fc.SocketPath
+	}
+	if fc.DataFile != "" {
+		c.DataFile = fc.DataFile
+	}
+	if fc.ReportsDir != "" {
+		c.ReportsDir = fc.ReportsDir
+	}
+	if fc.AttachmentsDir != "" {
+		c.AttachmentsDir = fc.AttachmentsDir
+	}
+	if fc.AttachmentScanClamAVAddr != "" {
+		c.AttachmentScanClamAVAddr = fc.AttachmentScanClamAVAddr
+	}
+	if fc.AdminBindAddress != "" {
+		c.AdminBindAddress = fc.AdminBindAddress
+	}
+	if len(fc.CORSOrigins) > 0 {
+		c.CORSOrigins = fc.CORSOrigins
+	}
+	if fc.AuthMode != "" {
+		c.AuthMode = fc.AuthMode
+	}
+	if fc.Timezone != "" {
+		c.Timezone = fc.Timezone
+	}
+	if fc.SweepInterval != "" {
+		d, err := time.ParseDuration(fc.SweepInterval)
+		if err != nil {
+			return fmt.Errorf("invalid sweep_interval %q: %w", fc.SweepInterval, err)
+		}
+		c.SweepInterval = d
+	}
+	return nil
+}
+
+func (c *Config) mergeEnv() {
+	if v := os.Getenv("PORT"); v != "" {
+		c.Port = v
+	}
+	if v := os.Getenv("BIND_ADDRESS"); v != "" {
+		c.BindAddress = v
+	}
+	if v := os.Getenv("SOCKET_PATH"); v != "" {
+		c.SocketPath = v
+	}
+	if v := os.Getenv("DATA_FILE"); v != "" {
+		c.DataFile = v
+	}
+	if v := os.Getenv("REPORTS_DIR"); v != "" {
+		c.ReportsDir = v
+	}
+	if v := os.Getenv("ATTACHMENTS_DIR"); v != "" {
+		c.AttachmentsDir = v
+	}
+	if v := os.Getenv("ATTACHMENT_SCAN_CLAMAV_ADDR"); v != "" {
+		c.AttachmentScanClamAVAddr = v
+	}
+	if v := os.Getenv("ADMIN_BIND_ADDRESS"); v != "" {
+		c.AdminBindAddress = v
+	}
+	if v := os.Getenv("CORS_ORIGINS"); v != "" {
+		c.CORSOrigins = splitCSV(v)
+	}
+	if v := os.Getenv("AUTH_MODE"); v != "" {
+		c.AuthMode = v
+	}
+	if v := os.Getenv("TIMEZONE"); v != "" {
+		c.Timezone = v
+	}
+	if v := os.Getenv("SWEEP_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			c.SweepInterval = d
+		}
+	}
+}
+
+func splitCSV(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			out = append(out, trimmed)
+		}
+	}
+	return out
+}
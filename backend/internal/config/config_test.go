@@ -0,0 +1,64 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := Defaults()
+	if cfg != want {
+		t.Fatalf("got %+v, want %+v", cfg, want)
+	}
+}
+
+func TestLoadFlagsOverrideEverything(t *testing.T) {
+	t.Setenv("PORT", "9000")
+	cfg, err := Load([]string{"-port", "9001", "-auth-mode", "none"})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Port != "9001" {
+		t.Fatalf("expected flag to win over env, got port %q", cfg.Port)
+	}
+	if cfg.AuthMode != "none" {
+		t.Fatalf("expected auth mode %q, got %q", "none", cfg.AuthMode)
+	}
+}
+
+func TestLoadEnvOverridesFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"port": "7000", "sweep_interval": "30m"}`), 0o644); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+	t.Setenv("PORT", "7001")
+
+	cfg, err := Load([]string{"-config", path})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Port != "7001" {
+		t.Fatalf("expected env to win over file, got port %q", cfg.Port)
+	}
+	if cfg.SweepInterval != 30*time.Minute {
+		t.Fatalf("expected sweep interval from file, got %v", cfg.SweepInterval)
+	}
+}
+
+func TestLoadParsesCORSOrigins(t *testing.T) {
+	cfg, err := Load([]string{"-cors-origins", "https://a.example, https://b.example"})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := []string{"https://a.example", "https://b.example"}
+	if len(cfg.CORSOrigins) != len(want) || cfg.CORSOrigins[0] != want[0] || cfg.CORSOrigins[1] != want[1] {
+		t.Fatalf("got %v, want %v", cfg.CORSOrigins, want)
+	}
+}
@@ -0,0 +1,63 @@
+// Package cpi adjusts historical money amounts for inflation using a
+// pluggable consumer price index data source, so long-range trend charts
+// can show spending in constant currency instead of nominal dollars.
+package cpi
+
+import (
+	"strconv"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Source supplies the CPI index value for a given calendar year. Index
+// values only matter relative to each other; there's no fixed base year.
+type Source interface {
+	IndexFor(year int) (value float64, ok bool)
+}
+
+// StaticSource is a Source backed by a fixed year-to-index table, e.g.
+// loaded from settings or a config file. It's the only Source this repo
+// ships, but callers depend on the Source interface so a future live feed
+// (e.g. a BLS API client) can be swapped in without touching call sites.
+type StaticSource map[int]float64
+
+// IndexFor implements Source.
+func (s StaticSource) IndexFor(year int) (float64, bool) {
+	v, ok := s[year]
+	return v, ok
+}
+
+// FromTable builds a StaticSource from a year-string-keyed index table, the
+// shape settings.Settings.CPIIndex stores it in since JSON object keys must
+// be strings. Keys that don't parse as a year are skipped.
+func FromTable(table map[string]float64) StaticSource {
+	src := make(StaticSource, len(table))
+	for k, v := range table {
+		year, err := strconv.Atoi(k)
+		if err != nil {
+			continue
+		}
+		src[year] = v
+	}
+	return src
+}
+
+// Adjust converts amount, recorded in fromYear, into constant toYear
+// currency using src, rounding the result with mode. It returns amount
+// unchanged if src is nil or either year's index is unavailable, since
+// leaving a value nominal is a safer failure mode than silently distorting
+// it.
+func Adjust(amount money.Money, fromYear, toYear int, src Source, mode money.RoundingMode) money.Money {
+	if src == nil || fromYear == toYear {
+		return amount
+	}
+	from, ok := src.IndexFor(fromYear)
+	if !ok || from == 0 {
+		return amount
+	}
+	to, ok := src.IndexFor(toYear)
+	if !ok {
+		return amount
+	}
+	return money.FromFloatRounding(amount.Float64()*to/from, mode)
+}
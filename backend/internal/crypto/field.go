@@ -0,0 +1,90 @@
+// Package crypto encrypts free-text fields before they're written to the
+// data file, so a leaked file reveals amounts and categories but not
+// descriptive details like notes and merchant names.
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// fieldPrefix marks a value as ciphertext produced by EncryptField, so
+// DecryptField can tell it apart from a plaintext value written before
+// encryption was enabled (or when it's left empty).
+const fieldPrefix = "enc:"
+
+// DeriveUserKey derives a per-user AES-256 key from a master key and the
+// user's ID, so leaking one user's key (or the data file, which carries no
+// keys at all) doesn't expose every user's fields.
+func DeriveUserKey(master []byte, userID string) []byte {
+	mac := hmac.New(sha256.New, master)
+	mac.Write([]byte(userID))
+	return mac.Sum(nil)
+}
+
+// EncryptField encrypts plaintext with AES-256-GCM under key, returning a
+// base64-encoded "enc:" value safe to store in a JSON string field. An empty
+// plaintext is left as-is, so absent notes/merchants don't need a key at
+// all.
+func EncryptField(key []byte, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", fmt.Errorf("create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", fmt.Errorf("create GCM: %w", err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return fieldPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptField reverses EncryptField. A value without the "enc:" prefix is
+// returned unchanged, so fields written before encryption was enabled still
+// read back correctly.
+func DecryptField(key []byte, value string) (string, error) {
+	rest, ok := cutPrefix(value)
+	if !ok {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(rest)
+	if err != nil {
+		return "", fmt.Errorf("decode ciphertext: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", fmt.Errorf("create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", fmt.Errorf("create GCM: %w", err)
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func cutPrefix(value string) (string, bool) {
+	if len(value) < len(fieldPrefix) || value[:len(fieldPrefix)] != fieldPrefix {
+		return "", false
+	}
+	return value[len(fieldPrefix):], true
+}
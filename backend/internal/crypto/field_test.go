@@ -0,0 +1,43 @@
+package crypto
+
+import "testing"
+
+func TestEncryptDecryptFieldRoundTrips(t *testing.T) {
+	key := DeriveUserKey([]byte("master-secret"), "user-1")
+	encrypted, err := EncryptField(key, "Whole Foods")
+	if err != nil {
+		t.Fatalf("EncryptField: %v", err)
+	}
+	if encrypted == "Whole Foods" {
+		t.Fatal("expected EncryptField to actually encrypt the value")
+	}
+	decrypted, err := DecryptField(key, encrypted)
+	if err != nil {
+		t.Fatalf("DecryptField: %v", err)
+	}
+	if decrypted != "Whole Foods" {
+		t.Fatalf("got %q, want %q", decrypted, "Whole Foods")
+	}
+}
+
+func TestDecryptFieldPassesThroughPlaintext(t *testing.T) {
+	key := DeriveUserKey([]byte("master-secret"), "user-1")
+	got, err := DecryptField(key, "unencrypted merchant")
+	if err != nil {
+		t.Fatalf("DecryptField: %v", err)
+	}
+	if got != "unencrypted merchant" {
+		t.Fatalf("got %q, want unchanged plaintext", got)
+	}
+}
+
+func TestEncryptFieldLeavesEmptyStringAlone(t *testing.T) {
+	key := DeriveUserKey([]byte("master-secret"), "user-1")
+	got, err := EncryptField(key, "")
+	if err != nil {
+		t.Fatalf("EncryptField: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("got %q, want empty string", got)
+	}
+}
@@ -0,0 +1,120 @@
+// Package debt projects payoff timelines for tracked debts under the
+// snowball and avalanche extra-payment strategies.
+package debt
+
+import (
+	"fmt"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Strategy is the order extra payment is applied to debts beyond each
+// debt's minimum payment.
+type Strategy string
+
+const (
+	// Snowball pays extra toward the smallest balance first, for
+	// motivational quick wins.
+	Snowball Strategy = "snowball"
+	// Avalanche pays extra toward the highest APR first, minimizing total
+	// interest paid.
+	Avalanche Strategy = "avalanche"
+)
+
+// Projection is the month-by-month payoff plan for a single debt.
+type Projection struct {
+	DebtID         string      `json:"debt_id"`
+	Name           string      `json:"name"`
+	MonthsToPayoff int         `json:"months_to_payoff"`
+	TotalInterest  money.Money `json:"total_interest"`
+	PayoffOrder    int         `json:"payoff_order"`
+}
+
+// Project simulates paying every active debt's minimum payment plus
+// extraMonthly applied in strategy order, until all balances reach zero,
+// and returns each debt's months-to-payoff, total interest paid, and the
+// order it's paid off in. Balances and payments are simulated in a local
+// copy; the store is not modified. Interest accrues fractionally, so the
+// simulation runs in float64 major units and only converts back to Money
+// for the final totals.
+func Project(debts []*model.Debt, extraMonthly money.Money, strategy Strategy) ([]Projection, error) {
+	if strategy != Snowball && strategy != Avalanche {
+		return nil, fmt.Errorf("unknown strategy %q", strategy)
+	}
+	type sim struct {
+		debt      *model.Debt
+		balance   float64
+		interest  float64
+		months    int
+		payoffPos int
+		done      bool
+	}
+	sims := make([]*sim, 0, len(debts))
+	for _, d := range debts {
+		if d.Active {
+			sims = append(sims, &sim{debt: d, balance: d.Balance.Float64()})
+		}
+	}
+	order := func() []*sim {
+		remaining := make([]*sim, 0, len(sims))
+		for _, s := range sims {
+			if !s.done {
+				remaining = append(remaining, s)
+			}
+		}
+		sort.Slice(remaining, func(i, j int) bool {
+			if strategy == Avalanche {
+				return remaining[i].debt.APR > remaining[j].debt.APR
+			}
+			return remaining[i].balance < remaining[j].balance
+		})
+		return remaining
+	}
+
+	payoffCount := 0
+	for i := 0; i < 1200; i++ { // cap at 100 years so a bad input can't loop forever
+		remaining := order()
+		if len(remaining) == 0 {
+			break
+		}
+		extra := extraMonthly.Float64()
+		for _, s := range remaining {
+			monthlyRate := s.debt.APR / 100 / 12
+			interest := s.balance * monthlyRate
+			s.interest += interest
+			s.balance += interest
+
+			payment := s.debt.MinimumPayment.Float64()
+			if extra > 0 {
+				payment += extra
+				extra = 0
+			}
+			if payment > s.balance {
+				payment = s.balance
+			}
+			s.balance -= payment
+			s.months++
+			if s.balance <= 0.01 {
+				s.balance = 0
+				s.done = true
+				payoffCount++
+				s.payoffPos = payoffCount
+			}
+		}
+	}
+
+	projections := make([]Projection, 0, len(sims))
+	for _, s := range sims {
+		projections = append(projections, Projection{
+			DebtID:         s.debt.ID,
+			Name:           s.debt.Name,
+			MonthsToPayoff: s.months,
+			TotalInterest:  money.FromFloat(s.interest),
+			PayoffOrder:    s.payoffPos,
+		})
+	}
+	sort.Slice(projections, func(i, j int) bool { return projections[i].PayoffOrder < projections[j].PayoffOrder })
+	return projections, nil
+}
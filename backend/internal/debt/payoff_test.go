@@ -0,0 +1,44 @@
+package debt
+
+import (
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestProjectAvalanchePaysHighestAPRFirst(t *testing.T) {
+	debts := []*model.Debt{
+		{ID: "d1", Name: "Card A", Balance: 1000, APR: 25, MinimumPayment: 50, Active: true},
+		{ID: "d2", Name: "Card B", Balance: 1000, APR: 10, MinimumPayment: 50, Active: true},
+	}
+	projections, err := Project(debts, 200, Avalanche)
+	if err != nil {
+		t.Fatalf("Project: %v", err)
+	}
+	if len(projections) != 2 {
+		t.Fatalf("got %d projections, want 2", len(projections))
+	}
+	if projections[0].DebtID != "d1" {
+		t.Errorf("expected higher-APR debt d1 to pay off first, got %s", projections[0].DebtID)
+	}
+}
+
+func TestProjectSnowballPaysSmallestBalanceFirst(t *testing.T) {
+	debts := []*model.Debt{
+		{ID: "d1", Name: "Big", Balance: 2000, APR: 15, MinimumPayment: 50, Active: true},
+		{ID: "d2", Name: "Small", Balance: 200, APR: 5, MinimumPayment: 20, Active: true},
+	}
+	projections, err := Project(debts, 100, Snowball)
+	if err != nil {
+		t.Fatalf("Project: %v", err)
+	}
+	if projections[0].DebtID != "d2" {
+		t.Errorf("expected smallest-balance debt d2 to pay off first, got %s", projections[0].DebtID)
+	}
+}
+
+func TestProjectRejectsUnknownStrategy(t *testing.T) {
+	if _, err := Project(nil, 0, "bogus"); err == nil {
+		t.Error("expected error for unknown strategy")
+	}
+}
@@ -0,0 +1,94 @@
+// Package digest builds and renders the periodic spending summary email.
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/template"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/recurring"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// CategoryMover is a category's total for the digest period alongside how
+// much it changed from the previous period of the same length.
+type CategoryMover struct {
+	Category string
+	Total    money.Money
+	Change   money.Money
+}
+
+// Digest is the content of a single digest email.
+type Digest struct {
+	PeriodLabel   string
+	Total         money.Money
+	PrevTotal     money.Money
+	Movers        []CategoryMover
+	UpcomingBills []recurring.Occurrence
+}
+
+// Build computes a Digest for the period of granularity g containing now,
+// comparing it against the period immediately before it, and projecting
+// bills due before the next period starts.
+func Build(expenses []*model.Expense, patterns []*model.RecurringPattern, g period.Granularity, s settings.Settings, now time.Time) Digest {
+	periodStart := period.Start(now, g, s)
+	periodEnd := period.Next(periodStart, g)
+	prevStart := period.Start(periodStart.Add(-time.Nanosecond), g, s)
+
+	current := make(map[string]money.Money)
+	previous := make(map[string]money.Money)
+	var total, prevTotal money.Money
+	for _, e := range expenses {
+		switch {
+		case !e.Date.Time().Before(periodStart) && e.Date.Time().Before(periodEnd):
+			current[e.Category] += e.Amount
+			total += e.Amount
+		case !e.Date.Time().Before(prevStart) && e.Date.Time().Before(periodStart):
+			previous[e.Category] += e.Amount
+			prevTotal += e.Amount
+		}
+	}
+
+	movers := make([]CategoryMover, 0, len(current))
+	for category, amount := range current {
+		movers = append(movers, CategoryMover{Category: category, Total: amount, Change: amount - previous[category]})
+	}
+	sort.Slice(movers, func(i, j int) bool { return movers[i].Change > movers[j].Change })
+
+	return Digest{
+		PeriodLabel:   fmt.Sprintf("%s to %s", periodStart.Format("Jan 2"), periodEnd.AddDate(0, 0, -1).Format("Jan 2")),
+		Total:         total,
+		PrevTotal:     prevTotal,
+		Movers:        movers,
+		UpcomingBills: recurring.Upcoming(patterns, periodStart, periodEnd),
+	}
+}
+
+const bodyTemplate = `Spending digest: {{.PeriodLabel}}
+
+Total spent: {{.Total}} (previous period: {{.PrevTotal}})
+
+Category movers:
+{{range .Movers}}  {{.Category}}: {{.Total}} ({{if ge .Change 0}}+{{end}}{{.Change}} vs previous period)
+{{else}}  no spending recorded
+{{end}}
+Upcoming bills:
+{{range .UpcomingBills}}  {{.Date.Format "Jan 2"}} - {{.Pattern.Category}}: {{.Pattern.Amount}}
+{{else}}  none
+{{end}}`
+
+var tmpl = template.Must(template.New("digest").Parse(bodyTemplate))
+
+// Render renders d into an email subject and plain-text body.
+func Render(d Digest) (subject, body string) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		return "Spending digest", fmt.Sprintf("failed to render digest: %v", err)
+	}
+	return fmt.Sprintf("Spending digest: %s", d.PeriodLabel), buf.String()
+}
@@ -0,0 +1,49 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+func TestBuildComparesAgainstPreviousPeriod(t *testing.T) {
+	s := settings.Default()
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	expenses := []*model.Expense{
+		{Category: "Groceries", Amount: 100, Date: civildate.New(2026, time.March, 10)},
+		{Category: "Groceries", Amount: 40, Date: civildate.New(2026, time.February, 10)},
+	}
+
+	d := Build(expenses, nil, period.Month, s, now)
+	if d.Total != 100 {
+		t.Errorf("got total %v, want 100", d.Total)
+	}
+	if d.PrevTotal != 40 {
+		t.Errorf("got prev total %v, want 40", d.PrevTotal)
+	}
+	if len(d.Movers) != 1 || d.Movers[0].Change != 60 {
+		t.Errorf("got movers %+v, want a single Groceries mover with change 60", d.Movers)
+	}
+}
+
+func TestRenderIncludesTotalsAndBills(t *testing.T) {
+	d := Digest{
+		PeriodLabel: "Mar 1 to Mar 31",
+		Total:       12000,
+		PrevTotal:   8000,
+		Movers:      []CategoryMover{{Category: "Rent", Total: 10000, Change: 2000}},
+	}
+	subject, body := Render(d)
+	if !strings.Contains(subject, "Mar 1 to Mar 31") {
+		t.Errorf("subject missing period label: %q", subject)
+	}
+	if !strings.Contains(body, "Rent: 100.00") {
+		t.Errorf("body missing mover line: %q", body)
+	}
+}
@@ -0,0 +1,59 @@
+package digest
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/mailer"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// Granularity maps a settings.DigestFrequency value to a period.Granularity,
+// defaulting to a monthly digest for unrecognized values.
+func Granularity(frequency string) period.Granularity {
+	if frequency == "week" {
+		return period.Week
+	}
+	return period.Month
+}
+
+// RunScheduler checks once per interval whether a digest is due and, if so,
+// builds and sends it. It blocks until stop is closed, so callers should run
+// it in its own goroutine.
+func RunScheduler(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if err := checkAndSend(st, now); err != nil {
+				slog.Error("digest: send failed", "error", err)
+			}
+		}
+	}
+}
+
+func checkAndSend(st *store.Store, now time.Time) error {
+	s := st.Settings()
+	if !s.DigestEnabled || s.DigestRecipient == "" {
+		return nil
+	}
+	g := Granularity(s.DigestFrequency)
+	periodStart := period.Start(now, g, s)
+	if !periodStart.After(s.DigestLastSent) {
+		return nil
+	}
+
+	d := Build(st.List(), st.ListRecurring(false), g, s, now)
+	subject, body := Render(d)
+	if err := mailer.Send(s.SMTP, s.DigestRecipient, subject, body); err != nil {
+		return err
+	}
+
+	s.DigestLastSent = periodStart
+	return st.UpdateSettings(context.Background(), s)
+}
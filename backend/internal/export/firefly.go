@@ -0,0 +1,48 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// FireflyCSV renders expenses as a CSV matching Firefly III's importer
+// column layout for withdrawal transactions.
+func FireflyCSV(expenses []*model.Expense) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"date", "amount", "description", "category", "opposing_account", "notes"}); err != nil {
+		return nil, err
+	}
+	for _, e := range expenses {
+		row := []string{
+			e.Date.String(),
+			formatAmount(e.Amount),
+			description(e),
+			e.Category,
+			e.Merchant,
+			e.Note,
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func description(e *model.Expense) string {
+	if e.Note != "" {
+		return e.Note
+	}
+	if e.Merchant != "" {
+		return e.Merchant
+	}
+	return e.Category
+}
+
+func formatAmount(amount money.Money) string {
+	return amount.String()
+}
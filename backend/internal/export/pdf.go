@@ -0,0 +1,90 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"halclarke97/budgetapp/backend/internal/report"
+)
+
+// MonthlyReportPDF renders a Monthly report as a one-page PDF: the period
+// total, a category breakdown, and the largest expenses.
+func MonthlyReportPDF(m report.Monthly) []byte {
+	doc := newPDFDoc()
+	doc.Line("Monthly Report: %s", m.Month)
+	doc.Line("Total spent: %s", m.Total)
+	doc.Line("")
+	doc.Line("By category:")
+	for _, c := range m.CategoryTotals {
+		doc.Line("  %s: %s", c.Merchant, c.Total)
+	}
+	doc.Line("")
+	doc.Line("Largest expenses:")
+	for _, e := range m.LargestExpenses {
+		doc.Line("  %s  %s  %s  %s", e.Date.String(), e.Category, e.Amount, e.Merchant)
+	}
+	return doc.Bytes()
+}
+
+// pdfDoc builds a minimal single-page PDF containing left-aligned lines of
+// text in Helvetica. It supports only what budgetapp's reports need: no
+// images, wrapping, or multi-page flow.
+type pdfDoc struct {
+	lines []string
+}
+
+func newPDFDoc() *pdfDoc { return &pdfDoc{} }
+
+func (d *pdfDoc) Line(format string, args ...any) {
+	d.lines = append(d.lines, fmt.Sprintf(format, args...))
+}
+
+// Bytes renders the accumulated lines into a complete PDF file.
+func (d *pdfDoc) Bytes() []byte {
+	var content bytes.Buffer
+	content.WriteString("BT /F1 11 Tf 40 780 Td 14 TL\n")
+	for _, line := range d.lines {
+		content.WriteString("(" + escapePDFText(line) + ") Tj T*\n")
+	}
+	content.WriteString("ET")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 4 0 R >> >> /MediaBox [0 0 612 792] /Contents 5 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects)+1)
+	for i, obj := range objects {
+		offsets[i+1] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xrefStart := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n", len(objects)+1)
+	buf.WriteString("0000000000 65535 f \n")
+	for i := 1; i <= len(objects); i++ {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offsets[i])
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF", len(objects)+1, xrefStart)
+
+	return buf.Bytes()
+}
+
+func escapePDFText(s string) string {
+	var out bytes.Buffer
+	for _, r := range s {
+		switch r {
+		case '(', ')', '\\':
+			out.WriteByte('\\')
+			out.WriteRune(r)
+		default:
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,44 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"halclarke97/budgetapp/backend/internal/report"
+)
+
+// MonthlyReportCSV renders a Monthly report as a two-section CSV: category
+// totals, then the period's largest expenses, so it can be opened directly
+// in a spreadsheet alongside the PDF version of the same report.
+func MonthlyReportCSV(m report.Monthly) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"category", "total"}); err != nil {
+		return nil, err
+	}
+	for _, c := range m.CategoryTotals {
+		if err := w.Write([]string{c.Merchant, c.Total.String()}); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Write([]string{"total", m.Total.String()}); err != nil {
+		return nil, err
+	}
+	if err := w.Write(nil); err != nil {
+		return nil, err
+	}
+
+	if err := w.Write([]string{"date", "category", "merchant", "amount"}); err != nil {
+		return nil, err
+	}
+	for _, e := range m.LargestExpenses {
+		row := []string{e.Date.String(), e.Category, e.Merchant, e.Amount.String()}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,209 @@
+// Package export renders expenses into downloadable file formats.
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"html"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// sheet is a minimal spreadsheet: rows of already-formatted cell strings.
+// Numeric cells are written as numbers, everything else as inline strings.
+type sheet struct {
+	name string
+	rows [][]cell
+}
+
+type cell struct {
+	value    string
+	isNumber bool
+}
+
+func str(v string) cell      { return cell{value: v} }
+func num(v money.Money) cell { return cell{value: v.String(), isNumber: true} }
+
+// XLSX renders expenses as an .xlsx workbook with a raw "Expenses" sheet and
+// a "Summary" sheet of category totals and a monthly pivot.
+func XLSX(expenses []*model.Expense) ([]byte, error) {
+	sheets := []sheet{
+		expenseSheet(expenses),
+		summarySheet(expenses),
+	}
+	return writeWorkbook(sheets)
+}
+
+func expenseSheet(expenses []*model.Expense) sheet {
+	s := sheet{name: "Expenses"}
+	s.rows = append(s.rows, []cell{str("Date"), str("Category"), str("Merchant"), str("Note"), str("Amount")})
+	for _, e := range expenses {
+		s.rows = append(s.rows, []cell{
+			str(e.Date.String()),
+			str(e.Category),
+			str(e.Merchant),
+			str(e.Note),
+			num(e.Amount),
+		})
+	}
+	return s
+}
+
+func summarySheet(expenses []*model.Expense) sheet {
+	byCategory := make(map[string]money.Money)
+	byMonth := make(map[string]map[string]money.Money) // month -> category -> total
+	months := map[string]bool{}
+	categories := map[string]bool{}
+	for _, e := range expenses {
+		byCategory[e.Category] += e.Amount
+		categories[e.Category] = true
+
+		month := e.Date.Time().Format("2006-01")
+		months[month] = true
+		if byMonth[month] == nil {
+			byMonth[month] = make(map[string]money.Money)
+		}
+		byMonth[month][e.Category] += e.Amount
+	}
+
+	s := sheet{name: "Summary"}
+	s.rows = append(s.rows, []cell{str("Category"), str("Total")})
+	catList := sortedKeys(categories)
+	for _, c := range catList {
+		s.rows = append(s.rows, []cell{str(c), num(byCategory[c])})
+	}
+
+	s.rows = append(s.rows, []cell{}) // blank separator row
+	header := []cell{str("Month")}
+	for _, c := range catList {
+		header = append(header, str(c))
+	}
+	s.rows = append(s.rows, header)
+	for _, m := range sortedKeys(months) {
+		row := []cell{str(m)}
+		for _, c := range catList {
+			row = append(row, num(byMonth[m][c]))
+		}
+		s.rows = append(s.rows, row)
+	}
+	return s
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeWorkbook(sheets []sheet) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	files := map[string]string{
+		"[Content_Types].xml":        contentTypesXML(len(sheets)),
+		"_rels/.rels":                rootRelsXML,
+		"xl/workbook.xml":            workbookXML(sheets),
+		"xl/_rels/workbook.xml.rels": workbookRelsXML(len(sheets)),
+	}
+	for i, sh := range sheets {
+		files[fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1)] = worksheetXML(sh)
+	}
+
+	// Zip entries in a stable order for reproducible output.
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w, err := zw.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(files[name])); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const rootRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+func contentTypesXML(n int) string {
+	var overrides bytes.Buffer
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&overrides, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+` + overrides.String() + `</Types>`
+}
+
+func workbookXML(sheets []sheet) string {
+	var sheetTags bytes.Buffer
+	for i, sh := range sheets {
+		fmt.Fprintf(&sheetTags, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, html.EscapeString(sh.name), i+1, i+1)
+	}
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets>` + sheetTags.String() + `</sheets>
+</workbook>`
+}
+
+func workbookRelsXML(n int) string {
+	var rels bytes.Buffer
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` + rels.String() + `</Relationships>`
+}
+
+func worksheetXML(sh sheet) string {
+	var rows bytes.Buffer
+	for r, row := range sh.rows {
+		fmt.Fprintf(&rows, `<row r="%d">`, r+1)
+		for c, cl := range row {
+			ref := colRef(c) + fmt.Sprint(r+1)
+			if cl.isNumber {
+				fmt.Fprintf(&rows, `<c r="%s"><v>%s</v></c>`, ref, cl.value)
+			} else {
+				fmt.Fprintf(&rows, `<c r="%s" t="inlineStr"><is><t>%s</t></is></c>`, ref, html.EscapeString(cl.value))
+			}
+		}
+		rows.WriteString(`</row>`)
+	}
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData>` + rows.String() + `</sheetData>
+</worksheet>`
+}
+
+// colRef converts a zero-based column index to its spreadsheet letter(s),
+// e.g. 0 -> "A", 26 -> "AA".
+func colRef(i int) string {
+	s := ""
+	for {
+		s = string(rune('A'+i%26)) + s
+		i = i/26 - 1
+		if i < 0 {
+			break
+		}
+	}
+	return s
+}
@@ -0,0 +1,39 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestXLSXProducesValidWorkbook(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 1250, Category: "food", Merchant: "Cafe", Date: civildate.New(2026, time.January, 5)},
+		{Amount: 4000, Category: "transport", Date: civildate.New(2026, time.February, 1)},
+	}
+
+	data, err := XLSX(expenses)
+	if err != nil {
+		t.Fatalf("XLSX: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("not a valid zip: %v", err)
+	}
+
+	want := []string{"xl/workbook.xml", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"}
+	got := map[string]bool{}
+	for _, f := range zr.File {
+		got[f.Name] = true
+	}
+	for _, name := range want {
+		if !got[name] {
+			t.Errorf("missing entry %s", name)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// YNABRegister renders expenses as a YNAB register CSV, importable via
+// YNAB's "File Based Import". YNAB records outflows as positive numbers in
+// a dedicated Outflow column.
+func YNABRegister(expenses []*model.Expense) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"Date", "Payee", "Category", "Memo", "Outflow", "Inflow"}); err != nil {
+		return nil, err
+	}
+	for _, e := range expenses {
+		row := []string{
+			e.Date.Time().Format("01/02/2006"),
+			e.Merchant,
+			e.Category,
+			e.Note,
+			formatAmount(e.Amount),
+			"0",
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
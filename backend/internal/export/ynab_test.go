@@ -0,0 +1,38 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestYNABRegisterFormatsOutflows(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 1250, Category: "food", Merchant: "Cafe", Date: civildate.New(2026, time.January, 5)},
+	}
+	data, err := YNABRegister(expenses)
+	if err != nil {
+		t.Fatalf("YNABRegister: %v", err)
+	}
+	out := string(data)
+	if !strings.Contains(out, "01/05/2026,Cafe,food,,12.50,0") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+func TestFireflyCSVFallsBackToCategoryForDescription(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 4000, Category: "transport", Date: civildate.New(2026, time.February, 1)},
+	}
+	data, err := FireflyCSV(expenses)
+	if err != nil {
+		t.Fatalf("FireflyCSV: %v", err)
+	}
+	out := string(data)
+	if !strings.Contains(out, "2026-02-01,40.00,transport,transport,,") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
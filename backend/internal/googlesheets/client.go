@@ -0,0 +1,110 @@
+// Package googlesheets pushes expenses into a Google Sheet via the Sheets
+// API v4, authenticating with a long-lived OAuth refresh token.
+package googlesheets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Config holds the OAuth client and target spreadsheet for the sync.
+type Config struct {
+	ClientID      string
+	ClientSecret  string
+	RefreshToken  string
+	SpreadsheetID string
+	SheetName     string
+}
+
+// Enabled reports whether cfg has everything needed to sync.
+func (cfg Config) Enabled() bool {
+	return cfg.ClientID != "" && cfg.RefreshToken != "" && cfg.SpreadsheetID != ""
+}
+
+// Client talks to the Sheets API using access tokens minted from Config's
+// refresh token.
+type Client struct {
+	cfg Config
+	// TokenURL and SheetsBaseURL default to Google's production endpoints;
+	// overridable in tests.
+	TokenURL      string
+	SheetsBaseURL string
+	HTTPClient    *http.Client
+}
+
+// NewClient returns a Client for cfg.
+func NewClient(cfg Config) *Client {
+	return &Client{
+		cfg:           cfg,
+		TokenURL:      "https://oauth2.googleapis.com/token",
+		SheetsBaseURL: "https://sheets.googleapis.com/v4/spreadsheets",
+		HTTPClient:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (c *Client) accessToken() (string, error) {
+	form := url.Values{
+		"client_id":     {c.cfg.ClientID},
+		"client_secret": {c.cfg.ClientSecret},
+		"refresh_token": {c.cfg.RefreshToken},
+		"grant_type":    {"refresh_token"},
+	}
+	resp, err := c.HTTPClient.PostForm(c.TokenURL, form)
+	if err != nil {
+		return "", fmt.Errorf("refresh access token: %w", err)
+	}
+	defer resp.Body.Close()
+	var body struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("decode token response: %w", err)
+	}
+	if resp.StatusCode >= 300 || body.AccessToken == "" {
+		return "", fmt.Errorf("token refresh returned status %d", resp.StatusCode)
+	}
+	return body.AccessToken, nil
+}
+
+func (c *Client) valuesRequest(method, rangeSuffix string, rows [][]string) error {
+	token, err := c.accessToken()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]any{"values": rows})
+	if err != nil {
+		return err
+	}
+	rng := url.PathEscape(c.cfg.SheetName)
+	endpoint := fmt.Sprintf("%s/%s/values/%s%s", c.SheetsBaseURL, c.cfg.SpreadsheetID, rng, rangeSuffix)
+	req, err := http.NewRequest(method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sheets API returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// AppendRow appends a single row to the configured sheet.
+func (c *Client) AppendRow(row []string) error {
+	return c.valuesRequest(http.MethodPost, ":append?valueInputOption=USER_ENTERED", [][]string{row})
+}
+
+// ReplaceAll overwrites the sheet's contents with rows, used for a full
+// scheduled resync rather than incremental appends.
+func (c *Client) ReplaceAll(rows [][]string) error {
+	return c.valuesRequest(http.MethodPut, "?valueInputOption=USER_ENTERED", rows)
+}
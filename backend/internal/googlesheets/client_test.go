@@ -0,0 +1,84 @@
+package googlesheets
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAppendRowSendsBearerTokenAndValues(t *testing.T) {
+	oauth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "test-token"})
+	}))
+	defer oauth.Close()
+
+	var gotAuth, gotPath string
+	var gotBody map[string][][]string
+	sheets := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotPath = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer sheets.Close()
+
+	c := NewClient(Config{ClientID: "id", ClientSecret: "secret", RefreshToken: "refresh", SpreadsheetID: "sheet1", SheetName: "Expenses"})
+	c.TokenURL = oauth.URL
+	c.SheetsBaseURL = sheets.URL
+
+	if err := c.AppendRow([]string{"2026-01-05", "food", "Cafe"}); err != nil {
+		t.Fatalf("AppendRow: %v", err)
+	}
+	if gotAuth != "Bearer test-token" {
+		t.Errorf("Authorization = %q, want Bearer test-token", gotAuth)
+	}
+	if !strings.Contains(gotPath, "sheet1/values/Expenses") {
+		t.Errorf("path = %q, want to contain sheet1/values/Expenses", gotPath)
+	}
+	if len(gotBody["values"]) != 1 || gotBody["values"][0][1] != "food" {
+		t.Errorf("unexpected request body: %+v", gotBody)
+	}
+}
+
+func TestReplaceAllUsesPut(t *testing.T) {
+	oauth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "test-token"})
+	}))
+	defer oauth.Close()
+
+	var gotMethod string
+	sheets := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer sheets.Close()
+
+	c := NewClient(Config{ClientID: "id", RefreshToken: "refresh", SpreadsheetID: "sheet1", SheetName: "Sheet1"})
+	c.TokenURL = oauth.URL
+	c.SheetsBaseURL = sheets.URL
+
+	if err := c.ReplaceAll([][]string{{"date", "category"}}); err != nil {
+		t.Fatalf("ReplaceAll: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("method = %q, want PUT", gotMethod)
+	}
+}
+
+func TestConfigEnabled(t *testing.T) {
+	cases := []struct {
+		cfg  Config
+		want bool
+	}{
+		{Config{}, false},
+		{Config{ClientID: "id", RefreshToken: "r", SpreadsheetID: "s"}, true},
+		{Config{ClientID: "id"}, false},
+	}
+	for _, c := range cases {
+		if got := c.cfg.Enabled(); got != c.want {
+			t.Errorf("Config(%+v).Enabled() = %v, want %v", c.cfg, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package googlesheets
+
+import (
+	"log/slog"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// RunScheduler periodically performs a full resync of the configured
+// Google Sheet, when SyncMode is "full". Append-mode syncing happens
+// inline when expenses are created instead.
+func RunScheduler(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			syncOnce(st)
+		}
+	}
+}
+
+func syncOnce(st *store.Store) {
+	cfg := st.Settings().GoogleSheets
+	if !toConfig(cfg).Enabled() || cfg.SyncMode != "full" {
+		return
+	}
+	if err := FullSync(cfg, st.List()); err != nil {
+		slog.Error("google sheets full sync failed", "error", err)
+	}
+}
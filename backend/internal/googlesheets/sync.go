@@ -0,0 +1,52 @@
+package googlesheets
+
+import (
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+func toConfig(s settings.GoogleSheetsConfig) Config {
+	return Config{
+		ClientID:      s.ClientID,
+		ClientSecret:  s.ClientSecret,
+		RefreshToken:  s.RefreshToken,
+		SpreadsheetID: s.SpreadsheetID,
+		SheetName:     sheetNameOrDefault(s.SheetName),
+	}
+}
+
+func sheetNameOrDefault(name string) string {
+	if name == "" {
+		return "Sheet1"
+	}
+	return name
+}
+
+func expenseRow(e *model.Expense) []string {
+	return []string{
+		e.Date.String(),
+		e.Category,
+		e.Merchant,
+		e.Note,
+		e.Amount.String(),
+	}
+}
+
+// AppendExpense pushes a single newly created expense as a new row. Intended
+// for SyncMode "append", called right after the expense is saved.
+func AppendExpense(s settings.GoogleSheetsConfig, e *model.Expense) error {
+	c := NewClient(toConfig(s))
+	return c.AppendRow(expenseRow(e))
+}
+
+// FullSync overwrites the sheet with the current header row plus every
+// expense, used for SyncMode "full".
+func FullSync(s settings.GoogleSheetsConfig, expenses []*model.Expense) error {
+	c := NewClient(toConfig(s))
+	rows := make([][]string, 0, len(expenses)+1)
+	rows = append(rows, []string{"date", "category", "merchant", "note", "amount"})
+	for _, e := range expenses {
+		rows = append(rows, expenseRow(e))
+	}
+	return c.ReplaceAll(rows)
+}
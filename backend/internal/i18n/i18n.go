@@ -0,0 +1,81 @@
+// Package i18n localizes the short, fixed validation messages the API
+// returns in its "error" field. Callers keep passing the same stable
+// English string they always have (see internal/api.writeError) — that
+// string doubles as both the machine-readable error code and the English
+// fallback text, so localizing a message never requires touching the
+// handler that raises it, only adding an entry to catalog below.
+package i18n
+
+import "strings"
+
+// DefaultLanguage is used when the request has no Accept-Language header,
+// or none of its preferences match a language catalog has translations for.
+const DefaultLanguage = "en"
+
+// catalog maps a code (the English message text passed to writeError) to
+// its translation in each supported non-English language. Only messages
+// that have actually been translated need an entry; everything else falls
+// back to the code itself, which is exactly today's (untranslated)
+// behavior, so adding a code here is always a safe, additive change.
+var catalog = map[string]map[string]string{
+	"category is required": {
+		"es": "la categoría es obligatoria",
+		"fr": "la catégorie est obligatoire",
+	},
+	"invalid request body": {
+		"es": "cuerpo de la solicitud no válido",
+		"fr": "corps de requête invalide",
+	},
+	"amount must be positive": {
+		"es": "el importe debe ser positivo",
+		"fr": "le montant doit être positif",
+	},
+}
+
+// Translate returns code's translation for lang, falling back to code
+// itself (the English original) when lang isn't a recognized language or
+// no translation has been added for code yet.
+func Translate(code, lang string) string {
+	translations, ok := catalog[code]
+	if !ok {
+		return code
+	}
+	if text, ok := translations[lang]; ok {
+		return text
+	}
+	return code
+}
+
+// Language picks the best language from acceptLanguage (the raw value of
+// an HTTP Accept-Language header, e.g. "es-MX,es;q=0.9,en;q=0.8") that
+// catalog has any translations for, ignoring quality weighting since the
+// catalog is small enough that any listed preference is as good as
+// another. Returns DefaultLanguage if acceptLanguage is empty or none of
+// its preferences are supported.
+func Language(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		tag = strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+		if tag == "" {
+			continue
+		}
+		if tag == DefaultLanguage {
+			return DefaultLanguage
+		}
+		if supported(tag) {
+			return tag
+		}
+	}
+	return DefaultLanguage
+}
+
+// supported reports whether lang has at least one translated message in
+// catalog.
+func supported(lang string) bool {
+	for _, translations := range catalog {
+		if _, ok := translations[lang]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package i18n
+
+import "testing"
+
+func TestTranslateFallsBackToCodeWhenUntranslated(t *testing.T) {
+	if got := Translate("some new validation message", "es"); got != "some new validation message" {
+		t.Fatalf("got %q, want the code echoed back unchanged", got)
+	}
+}
+
+func TestTranslateReturnsCatalogEntry(t *testing.T) {
+	if got := Translate("category is required", "es"); got != "la categoría es obligatoria" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestLanguagePicksFirstSupportedPreference(t *testing.T) {
+	if got := Language("fr-CA,fr;q=0.9,en;q=0.8"); got != "fr" {
+		t.Fatalf("got %q, want fr", got)
+	}
+	if got := Language("de,es;q=0.5"); got != "es" {
+		t.Fatalf("got %q, want es (de isn't supported)", got)
+	}
+	if got := Language(""); got != DefaultLanguage {
+		t.Fatalf("got %q, want default", got)
+	}
+	if got := Language("xx-YY"); got != DefaultLanguage {
+		t.Fatalf("got %q, want default for an unsupported language", got)
+	}
+}
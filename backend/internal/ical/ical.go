@@ -0,0 +1,64 @@
+// Package ical renders iCalendar (RFC 5545) text and signs the feed tokens
+// used to authenticate subscription URLs without requiring a login.
+package ical
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/recurring"
+)
+
+// Sign returns the HMAC-SHA256 token for secret, used as the ?token= query
+// parameter on a feed URL. The token is opaque and does not encode an
+// expiry; revoke access by rotating the secret.
+func Sign(secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("recurring-expenses-feed"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether token matches the feed token for secret.
+func Verify(secret, token string) bool {
+	want := Sign(secret)
+	return hmac.Equal([]byte(want), []byte(token))
+}
+
+// RenderUpcoming produces a VCALENDAR document containing one VEVENT per
+// occurrence.
+func RenderUpcoming(occurrences []recurring.Occurrence) []byte {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//budgetapp//recurring-expenses//EN\r\n")
+	b.WriteString("CALSCALE:GREGORIAN\r\n")
+	for _, o := range occurrences {
+		p := o.Pattern
+		summary := p.Category
+		if p.Merchant != "" {
+			summary = fmt.Sprintf("%s - %s", p.Merchant, p.Category)
+		}
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:%s-%s@budgetapp\r\n", p.ID, o.Date.Format("20060102"))
+		fmt.Fprintf(&b, "DTSTAMP:%s\r\n", time.Now().UTC().Format("20060102T150405Z"))
+		fmt.Fprintf(&b, "DTSTART;VALUE=DATE:%s\r\n", o.Date.Format("20060102"))
+		fmt.Fprintf(&b, "SUMMARY:%s (%s)\r\n", escape(summary), p.Amount)
+		if p.Note != "" {
+			fmt.Fprintf(&b, "DESCRIPTION:%s\r\n", escape(recurring.ExpandNote(p.Note, o.Date)))
+		}
+		b.WriteString("END:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return []byte(b.String())
+}
+
+// escape applies the RFC 5545 TEXT escaping rules for the characters that
+// appear in our generated content.
+func escape(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `,`, `\,`, `;`, `\;`, "\n", `\n`)
+	return r.Replace(s)
+}
@@ -0,0 +1,41 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/recurring"
+)
+
+func TestVerifyAcceptsOnlyMatchingToken(t *testing.T) {
+	token := Sign("secret-a")
+	if !Verify("secret-a", token) {
+		t.Error("expected token to verify against the secret it was signed with")
+	}
+	if Verify("secret-b", token) {
+		t.Error("expected token to be rejected for a different secret")
+	}
+}
+
+func TestRenderUpcomingIncludesOneEventPerOccurrence(t *testing.T) {
+	p := &model.RecurringPattern{
+		ID:        "abc",
+		Amount:    1250,
+		Category:  "Subscriptions",
+		Merchant:  "Streamflix",
+		Frequency: model.FrequencyMonthly,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Active:    true,
+	}
+	occurrences := recurring.Upcoming([]*model.RecurringPattern{p}, p.StartDate, p.StartDate.AddDate(0, 3, 0))
+
+	out := string(RenderUpcoming(occurrences))
+	if got := strings.Count(out, "BEGIN:VEVENT"); got != len(occurrences) {
+		t.Errorf("got %d VEVENT blocks, want %d", got, len(occurrences))
+	}
+	if !strings.Contains(out, "Streamflix - Subscriptions") {
+		t.Errorf("expected summary to include merchant and category, got %q", out)
+	}
+}
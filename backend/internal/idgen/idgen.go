@@ -0,0 +1,14 @@
+// Package idgen generates opaque random identifiers for stored entities.
+package idgen
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// New returns a random 128-bit identifier encoded as a hex string.
+func New() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,125 @@
+// Package importer converts external file formats (bank exports, other
+// budgeting apps) into budgetapp expenses.
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// ColumnMapping tells the CSV importer which columns hold which fields, and
+// how to interpret amount sign, since bank exports vary widely.
+type ColumnMapping struct {
+	DateColumn        string `json:"date_column"`
+	DateFormat        string `json:"date_format"`
+	AmountColumn      string `json:"amount_column"`
+	// NegativeIsExpense is true when the source file records expenses as
+	// negative numbers (as most bank exports do). When false, positive
+	// numbers are treated as expenses instead.
+	NegativeIsExpense bool   `json:"negative_is_expense"`
+	CategoryColumn    string `json:"category_column"`
+	MerchantColumn    string `json:"merchant_column"`
+	NoteColumn        string `json:"note_column"`
+}
+
+// Row is a single parsed CSV line, either a successfully mapped expense or
+// an error describing why it couldn't be parsed.
+type Row struct {
+	Line    int            `json:"line"`
+	Expense *model.Expense `json:"expense,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// ParseCSV reads r as a CSV file with a header row and maps each subsequent
+// row to an expense per mapping. Rows that fail to parse are still
+// returned, with Error set, so the caller can present a full preview.
+func ParseCSV(r io.Reader, mapping ColumnMapping) ([]Row, error) {
+	if mapping.DateFormat == "" {
+		mapping.DateFormat = "2006-01-02"
+	}
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(name)] = i
+	}
+
+	col := func(name string) (int, bool) {
+		if name == "" {
+			return 0, false
+		}
+		i, ok := index[name]
+		return i, ok
+	}
+
+	dateIdx, ok := col(mapping.DateColumn)
+	if !ok {
+		return nil, fmt.Errorf("date column %q not found in header", mapping.DateColumn)
+	}
+	amountIdx, ok := col(mapping.AmountColumn)
+	if !ok {
+		return nil, fmt.Errorf("amount column %q not found in header", mapping.AmountColumn)
+	}
+	categoryIdx, hasCategory := col(mapping.CategoryColumn)
+	merchantIdx, hasMerchant := col(mapping.MerchantColumn)
+	noteIdx, hasNote := col(mapping.NoteColumn)
+
+	var rows []Row
+	line := 1
+	for {
+		line++
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rows = append(rows, Row{Line: line, Error: err.Error()})
+			continue
+		}
+
+		date, err := time.Parse(mapping.DateFormat, strings.TrimSpace(record[dateIdx]))
+		if err != nil {
+			rows = append(rows, Row{Line: line, Error: fmt.Sprintf("invalid date: %v", err)})
+			continue
+		}
+		amount, err := strconv.ParseFloat(strings.TrimSpace(record[amountIdx]), 64)
+		if err != nil {
+			rows = append(rows, Row{Line: line, Error: fmt.Sprintf("invalid amount: %v", err)})
+			continue
+		}
+		if mapping.NegativeIsExpense {
+			amount = -amount
+		}
+		if amount < 0 {
+			// Not an expense (e.g. a credit or refund line); skip silently.
+			continue
+		}
+
+		e := &model.Expense{Amount: money.FromFloat(amount), Date: civildate.FromTime(date)}
+		if hasCategory {
+			e.Category = strings.TrimSpace(record[categoryIdx])
+		}
+		if hasMerchant {
+			e.Merchant = strings.TrimSpace(record[merchantIdx])
+		}
+		if hasNote {
+			e.Note = strings.TrimSpace(record[noteIdx])
+		}
+		if e.Category == "" {
+			e.Category = "uncategorized"
+		}
+		rows = append(rows, Row{Line: line, Expense: e})
+	}
+	return rows, nil
+}
@@ -0,0 +1,41 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func TestParseCSVMapsColumnsAndSign(t *testing.T) {
+	data := "Posted,Amount,Desc\n2026-01-05,-12.50,Coffee Shop\n2026-01-06,500.00,Paycheck\n"
+	mapping := ColumnMapping{
+		DateColumn:        "Posted",
+		AmountColumn:      "Amount",
+		MerchantColumn:    "Desc",
+		NegativeIsExpense: true,
+	}
+
+	rows, err := ParseCSV(strings.NewReader(data), mapping)
+	if err != nil {
+		t.Fatalf("ParseCSV: %v", err)
+	}
+
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 expense row (paycheck credit skipped), got %d", len(rows))
+	}
+	e := rows[0].Expense
+	if e == nil {
+		t.Fatalf("expected parsed expense, got error: %s", rows[0].Error)
+	}
+	if e.Amount != money.FromFloat(12.5) || e.Merchant != "Coffee Shop" {
+		t.Errorf("unexpected expense: %+v", e)
+	}
+}
+
+func TestParseCSVUnknownColumnErrors(t *testing.T) {
+	_, err := ParseCSV(strings.NewReader("A,B\n1,2\n"), ColumnMapping{DateColumn: "Missing", AmountColumn: "B"})
+	if err == nil {
+		t.Fatal("expected error for missing date column")
+	}
+}
@@ -0,0 +1,52 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Fingerprint returns a normalized hash of date, amount, and merchant, stable
+// across whitespace and casing differences in the merchant name. It's
+// persisted on every model.Expense (see internal/store.Store.Add) so
+// re-imports of the same transaction can be caught with a cheap equality
+// check instead of a field-by-field scan.
+func Fingerprint(date civildate.Date, amount money.Money, merchant string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(merchant)), " ")
+	sum := sha256.Sum256([]byte(date.String() + "|" + amount.String() + "|" + normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsDuplicate reports whether e matches an existing expense. If e has an
+// ExternalID (set by import/sync integrations), that's an exact match.
+// Otherwise it compares e's Fingerprint against existing expenses' persisted
+// fingerprints; expenses saved before Fingerprint existed fall back to the
+// same coarse (date, amount, merchant) heuristic this always used.
+func IsDuplicate(existing []*model.Expense, e *model.Expense) bool {
+	fp := e.Fingerprint
+	if fp == "" {
+		fp = Fingerprint(e.Date, e.Amount, e.Merchant)
+	}
+	for _, ex := range existing {
+		if e.ExternalID != "" {
+			if ex.ExternalID == e.ExternalID {
+				return true
+			}
+			continue
+		}
+		if ex.Fingerprint != "" {
+			if ex.Fingerprint == fp {
+				return true
+			}
+			continue
+		}
+		if ex.Date == e.Date && ex.Amount == e.Amount && ex.Merchant == e.Merchant {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestIsDuplicateMatchesByExternalID(t *testing.T) {
+	existing := []*model.Expense{{ExternalID: "txn-1", Amount: 10, Date: civildate.Today(), Merchant: "Cafe"}}
+	e := &model.Expense{ExternalID: "txn-1", Amount: 999, Date: civildate.Today().AddDays(1), Merchant: "Different"}
+	if !IsDuplicate(existing, e) {
+		t.Error("expected match on ExternalID even though other fields differ")
+	}
+}
+
+func TestIsDuplicateFallsBackToHeuristicWithoutExternalID(t *testing.T) {
+	date := civildate.New(2026, time.January, 1)
+	existing := []*model.Expense{{Amount: 10, Date: date, Merchant: "Cafe"}}
+	if !IsDuplicate(existing, &model.Expense{Amount: 10, Date: date, Merchant: "Cafe"}) {
+		t.Error("expected heuristic match on date+amount+merchant")
+	}
+	if IsDuplicate(existing, &model.Expense{Amount: 11, Date: date, Merchant: "Cafe"}) {
+		t.Error("expected no match when amount differs")
+	}
+}
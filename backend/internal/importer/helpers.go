@@ -0,0 +1,63 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseGenericCSV reads a header CSV and calls build for each data row,
+// giving it a getter over named columns. Columns absent from the header
+// simply read as empty rather than erroring, since presets probe several
+// optional columns. build may return a zero Row to skip a line silently
+// (e.g. a transfer or credit).
+func parseGenericCSV(r io.Reader, build func(line int, get func(string) string) Row) ([]Row, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(name)] = i
+	}
+
+	var rows []Row
+	line := 1
+	for {
+		line++
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rows = append(rows, Row{Line: line, Error: err.Error()})
+			continue
+		}
+		get := func(name string) string {
+			i, ok := index[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		if row := build(line, get); row.Line != 0 || row.Expense != nil || row.Error != "" {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func parseDate(value, format string) (time.Time, error) {
+	if format == "" {
+		format = "2006-01-02"
+	}
+	return time.Parse(format, value)
+}
+
+func parseAmount(value string) (float64, error) {
+	return strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+}
@@ -0,0 +1,64 @@
+package importer
+
+import (
+	"io"
+	"strings"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// ParseYNAB parses a YNAB register export. YNAB splits amount into separate
+// Outflow/Inflow columns rather than a signed Amount column, and represents
+// account transfers as expenses with a "Transfer : <account>" category
+// (which is skipped), so it needs its own parser rather than the generic
+// mapped ParseCSV.
+func ParseYNAB(r io.Reader) ([]Row, error) {
+	return parseGenericCSV(r, func(line int, get func(string) string) Row {
+		category := get("Category")
+		if strings.HasPrefix(category, "Transfer :") {
+			return Row{}
+		}
+		date, err := parseDate(get("Date"), "01/02/2006")
+		if err != nil {
+			return Row{Line: line, Error: err.Error()}
+		}
+		amount, err := parseAmount(get("Outflow"))
+		if err != nil || amount <= 0 {
+			return Row{}
+		}
+		return Row{Line: line, Expense: &model.Expense{
+			Amount:   money.FromFloat(amount),
+			Date:     civildate.FromTime(date),
+			Category: orDefault(category),
+			Merchant: get("Payee"),
+			Note:     get("Memo"),
+		}}
+	})
+}
+
+// ParseMint parses a Mint "transactions.csv" export, which uses a single
+// signed Amount column plus a separate Transaction Type column instead of
+// a sign convention.
+func ParseMint(r io.Reader) ([]Row, error) {
+	return parseGenericCSV(r, func(line int, get func(string) string) Row {
+		if !strings.EqualFold(get("Transaction Type"), "debit") {
+			return Row{}
+		}
+		date, err := parseDate(get("Date"), "1/2/2006")
+		if err != nil {
+			return Row{Line: line, Error: err.Error()}
+		}
+		amount, err := parseAmount(get("Amount"))
+		if err != nil {
+			return Row{Line: line, Error: err.Error()}
+		}
+		return Row{Line: line, Expense: &model.Expense{
+			Amount:   money.FromFloat(amount),
+			Date:     civildate.FromTime(date),
+			Category: orDefault(get("Category")),
+			Merchant: get("Description"),
+		}}
+	})
+}
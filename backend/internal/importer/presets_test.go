@@ -0,0 +1,42 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func TestParseYNABSkipsTransfers(t *testing.T) {
+	data := "Date,Payee,Category,Memo,Outflow,Inflow\n" +
+		"01/05/2026,Coffee Shop,Dining,,4.50,\n" +
+		"01/06/2026,Savings,Transfer : Savings,,100.00,\n"
+
+	rows, err := ParseYNAB(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseYNAB: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row (transfer skipped), got %d", len(rows))
+	}
+	if rows[0].Expense.Amount != money.FromFloat(4.5) {
+		t.Errorf("unexpected amount: %v", rows[0].Expense.Amount)
+	}
+}
+
+func TestParseMintSkipsCredits(t *testing.T) {
+	data := "Date,Description,Amount,Transaction Type,Category\n" +
+		"1/5/2026,Coffee Shop,4.50,debit,Dining\n" +
+		"1/6/2026,Paycheck,500.00,credit,Income\n"
+
+	rows, err := ParseMint(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseMint: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row (credit skipped), got %d", len(rows))
+	}
+	if rows[0].Expense.Merchant != "Coffee Shop" {
+		t.Errorf("unexpected merchant: %s", rows[0].Expense.Merchant)
+	}
+}
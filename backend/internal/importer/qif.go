@@ -0,0 +1,114 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// ParseQIF reads a Quicken Interchange Format file. Each transaction is a
+// block of lines terminated by "^"; fields are keyed by a single leading
+// letter (D=date, T=amount, P=payee, M=memo, L=category, S=split category,
+// $=split amount). Split transactions produce one Row per split line, so a
+// single QIF transaction covering several categories still lands as
+// separate, individually categorized expenses.
+func ParseQIF(r io.Reader) ([]Row, error) {
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	type txn struct {
+		date     time.Time
+		amount   float64
+		payee    string
+		memo     string
+		category string
+		splits   []Row
+	}
+	cur := &txn{}
+	var rows []Row
+
+	flush := func() {
+		if cur.date.IsZero() && cur.amount == 0 && cur.payee == "" {
+			return
+		}
+		if len(cur.splits) > 0 {
+			rows = append(rows, cur.splits...)
+		} else if cur.amount > 0 {
+			rows = append(rows, Row{Line: line, Expense: &model.Expense{
+				Amount:   money.FromFloat(cur.amount),
+				Date:     civildate.FromTime(cur.date),
+				Merchant: cur.payee,
+				Note:     cur.memo,
+				Category: orDefault(cur.category),
+			}})
+		}
+		cur = &txn{}
+	}
+
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "!") {
+			continue
+		}
+		if text == "^" {
+			flush()
+			continue
+		}
+		field, value := text[:1], text[1:]
+		switch field {
+		case "D":
+			t, err := time.Parse("1/2/2006", value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid date %q: %w", line, value, err)
+			}
+			cur.date = t
+		case "T", "U":
+			amount, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid amount %q: %w", line, value, err)
+			}
+			// QIF records outflows as negative; expenses are positive.
+			cur.amount = -amount
+		case "P":
+			cur.payee = value
+		case "M":
+			cur.memo = value
+		case "L":
+			cur.category = value
+		case "S":
+			cur.splits = append(cur.splits, Row{Line: line, Expense: &model.Expense{Category: orDefault(value)}})
+		case "$":
+			if len(cur.splits) == 0 {
+				continue
+			}
+			amount, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid split amount %q: %w", line, value, err)
+			}
+			last := cur.splits[len(cur.splits)-1]
+			last.Expense.Amount = money.FromFloat(-amount)
+			last.Expense.Date = civildate.FromTime(cur.date)
+			last.Expense.Merchant = cur.payee
+		}
+	}
+	flush()
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func orDefault(category string) string {
+	if category == "" {
+		return "uncategorized"
+	}
+	return category
+}
@@ -0,0 +1,39 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func TestParseQIFBasicTransaction(t *testing.T) {
+	data := "!Type:Bank\nD1/5/2026\nT-12.50\nPCoffee Shop\nLFood:Coffee\n^\n"
+
+	rows, err := ParseQIF(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseQIF: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+	e := rows[0].Expense
+	if e.Amount != money.FromFloat(12.5) || e.Merchant != "Coffee Shop" || e.Category != "Food:Coffee" {
+		t.Errorf("unexpected expense: %+v", e)
+	}
+}
+
+func TestParseQIFSplitTransaction(t *testing.T) {
+	data := "D1/6/2026\nT-100.00\nPCostco\nSGroceries\n$-70.00\nSHousehold\n$-30.00\n^\n"
+
+	rows, err := ParseQIF(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseQIF: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 split rows, got %d", len(rows))
+	}
+	if rows[0].Expense.Amount != 70 || rows[1].Expense.Amount != 30 {
+		t.Errorf("unexpected split amounts: %v, %v", rows[0].Expense.Amount, rows[1].Expense.Amount)
+	}
+}
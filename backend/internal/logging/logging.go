@@ -0,0 +1,36 @@
+// Package logging configures the application's structured logger.
+package logging
+
+import (
+	"log/slog"
+	"os"
+)
+
+// New builds the process-wide structured logger from environment
+// configuration: LOG_LEVEL selects the minimum level ("debug", "info",
+// "warn", or "error"; defaults to info), and LOG_FORMAT=pretty switches to
+// a human-readable console handler for local development instead of the
+// default JSON output.
+func New() *slog.Logger {
+	opts := &slog.HandlerOptions{Level: parseLevel(os.Getenv("LOG_LEVEL"))}
+	var handler slog.Handler
+	if os.Getenv("LOG_FORMAT") == "pretty" {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	}
+	return slog.New(handler)
+}
+
+func parseLevel(s string) slog.Level {
+	switch s {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,28 @@
+// Package mailer sends outgoing email over SMTP.
+package mailer
+
+import (
+	"fmt"
+	"net/smtp"
+
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// Send delivers a plain-text email with the given subject and body to to,
+// using the server configured in cfg. It returns an error if cfg has no
+// host configured, so callers can distinguish "not configured" from a
+// delivery failure.
+func Send(cfg settings.SMTPConfig, to, subject, body string) error {
+	if cfg.Host == "" {
+		return fmt.Errorf("smtp not configured")
+	}
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=\"utf-8\"\r\n\r\n%s",
+		cfg.From, to, subject, body)
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return smtp.SendMail(addr, auth, cfg.From, []string{to}, []byte(msg))
+}
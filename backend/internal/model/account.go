@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// AccountType categorizes an Account for display and reporting purposes.
+type AccountType string
+
+const (
+	AccountCash       AccountType = "cash"
+	AccountChecking   AccountType = "checking"
+	AccountSavings    AccountType = "savings"
+	AccountCreditCard AccountType = "credit_card"
+)
+
+// Account is a wallet, card, or bank account expenses and income can be
+// attributed to, so cash vs. credit card spending can be tracked
+// separately.
+type Account struct {
+	ID   string      `json:"id"`
+	Name string      `json:"name"`
+	Type AccountType `json:"type"`
+	// OpeningBalance is the balance recorded before any tracked expenses or
+	// income, used as the base for the running balance.
+	OpeningBalance money.Money `json:"opening_balance"`
+	Active         bool        `json:"active"`
+	CreatedAt      time.Time   `json:"created_at"`
+	// LastReconciledAt is the statement date of the most recent
+	// reconciliation, so the UI can show how stale the account is.
+	LastReconciledAt time.Time `json:"last_reconciled_at,omitempty"`
+	// LastReconciledBalance is the statement balance from the most recent
+	// reconciliation.
+	LastReconciledBalance money.Money `json:"last_reconciled_balance,omitempty"`
+	// UserID is the account (user) this Account belongs to. Empty means it
+	// predates user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
+
+// Reconciliation is a record of matching an Account's balance against a
+// bank statement as of a point in time.
+type Reconciliation struct {
+	ID               string      `json:"id"`
+	AccountID        string      `json:"account_id"`
+	StatementBalance money.Money `json:"statement_balance"`
+	StatementDate    time.Time   `json:"statement_date"`
+	// ComputedBalance is the account's balance as tracked by budgetapp as
+	// of StatementDate, before this reconciliation.
+	ComputedBalance money.Money `json:"computed_balance"`
+	// Discrepancy is StatementBalance minus ComputedBalance; non-zero means
+	// an expense or income entry is missing or wrong.
+	Discrepancy money.Money `json:"discrepancy"`
+	// ReconciledCount is how many previously-unreconciled entries were
+	// marked reconciled by this pass.
+	ReconciledCount int       `json:"reconciled_count"`
+	CreatedAt       time.Time `json:"created_at"`
+}
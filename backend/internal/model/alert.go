@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// AlertKind is the condition an AlertRule checks.
+type AlertKind string
+
+const (
+	// AlertCategoryThreshold fires when a category's total for the current
+	// period reaches Amount.
+	AlertCategoryThreshold AlertKind = "category_threshold"
+	// AlertSingleExpense fires when any one expense's amount reaches
+	// Amount.
+	AlertSingleExpense AlertKind = "single_expense"
+)
+
+// AlertRule is a user-defined condition that triggers a notification when
+// met, e.g. "entertainment exceeds $200 this month" or "any expense over
+// $500".
+type AlertRule struct {
+	ID   string    `json:"id"`
+	Name string    `json:"name"`
+	Kind AlertKind `json:"kind"`
+	// Category filters AlertCategoryThreshold rules; empty matches all
+	// categories for AlertSingleExpense rules.
+	Category string `json:"category,omitempty"`
+	// Amount is the threshold that triggers the rule. Ignored by
+	// AlertCategoryThreshold rules that set PercentOfBudget instead.
+	Amount money.Money `json:"amount"`
+	// PercentOfBudget, if set, makes an AlertCategoryThreshold rule's
+	// threshold a percentage (e.g. 90 for 90%) of the active Budget.Limit
+	// for the same category and period, instead of a fixed Amount. Ignored
+	// by AlertSingleExpense rules, and if no matching budget exists the
+	// rule simply never fires.
+	PercentOfBudget float64 `json:"percent_of_budget,omitempty"`
+	// Period is the granularity ("day", "week", "month") a
+	// AlertCategoryThreshold rule's total resets on. Ignored by
+	// AlertSingleExpense rules.
+	Period string `json:"period,omitempty"`
+	Active bool   `json:"active"`
+	// LastFiredPeriod is the start of the period an AlertCategoryThreshold
+	// rule last fired for, so it fires once per period rather than once per
+	// expense that keeps it over the threshold.
+	LastFiredPeriod time.Time `json:"last_fired_period,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// AlertEvent is a record of an AlertRule firing.
+type AlertEvent struct {
+	ID          string    `json:"id"`
+	RuleID      string    `json:"rule_id"`
+	Message     string    `json:"message"`
+	TriggeredAt time.Time `json:"triggered_at"`
+}
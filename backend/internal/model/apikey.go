@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// KeyScope limits what an APIKey is allowed to do.
+type KeyScope string
+
+const (
+	KeyScopeReadOnly  KeyScope = "read-only"
+	KeyScopeReadWrite KeyScope = "read-write"
+)
+
+// APIKey lets a script or CLI tool authenticate without a browser session.
+// The raw key is only ever shown once, at creation time; KeyHash is what's
+// persisted and checked against on every request.
+type APIKey struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	Label     string     `json:"label,omitempty"`
+	Scope     KeyScope   `json:"scope"`
+	KeyHash   string     `json:"key_hash"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
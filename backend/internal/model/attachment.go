@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Attachment is a file (typically a receipt photo or PDF) uploaded against
+// an Expense. Its bytes are stored on disk under the server's attachments
+// directory, keyed by ID; this record is only the metadata needed to list,
+// download, and quota them.
+type Attachment struct {
+	ID          string    `json:"id"`
+	ExpenseID   string    `json:"expense_id"`
+	UserID      string    `json:"user_id,omitempty"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type,omitempty"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
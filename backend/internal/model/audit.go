@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// AuditEventType categorizes an AuditEvent.
+type AuditEventType string
+
+const (
+	AuditLoginSucceeded AuditEventType = "login_succeeded"
+	AuditLoginFailed    AuditEventType = "login_failed"
+	AuditAccountLocked  AuditEventType = "account_locked"
+	AuditPeriodClosed   AuditEventType = "period_closed"
+	AuditPeriodReopened AuditEventType = "period_reopened"
+)
+
+// AuditEvent is a record of a security-relevant action, e.g. an
+// authentication attempt, kept for later review.
+type AuditEvent struct {
+	ID    string         `json:"id"`
+	Type  AuditEventType `json:"type"`
+	Email string         `json:"email,omitempty"`
+	IP    string         `json:"ip,omitempty"`
+	// Detail is a short human-readable description, e.g. "5 failed attempts,
+	// locked for 2m0s".
+	Detail    string    `json:"detail,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
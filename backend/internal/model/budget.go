@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Budget caps spending in a single category over a recurring period.
+type Budget struct {
+	ID       string      `json:"id"`
+	Category string      `json:"category"`
+	Limit    money.Money `json:"limit"`
+	// Period is the granularity ("week" or "month") the limit resets on.
+	Period    string    `json:"period"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	// UserID is the account this budget belongs to. Empty means it predates
+	// user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
@@ -0,0 +1,29 @@
+package model
+
+// Category holds display metadata for an expense category name. Categories
+// are otherwise just free-text strings on Expense, Budget, and the like;
+// this is only the color/icon the frontend renders alongside a category's
+// totals, auto-assigned the first time a name is seen and overridable by
+// the user afterward.
+type Category struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+	Icon  string `json:"icon"`
+	// DefaultNote, DefaultPaymentMethod, and DefaultTaxRate are applied to
+	// a new expense created in this category when the corresponding field
+	// is left blank, so routine entries (the same coffee shop, the same
+	// card, the same sales tax rate) don't need retyping every time. Empty
+	// or zero means no default is set for that field.
+	DefaultNote          string  `json:"default_note,omitempty"`
+	DefaultPaymentMethod string  `json:"default_payment_method,omitempty"`
+	DefaultTaxRate       float64 `json:"default_tax_rate,omitempty"`
+	// RetentionDays, if set, is a legal/compliance minimum: expenses in this
+	// category are protected from internal/retention's expense purge until
+	// they're at least this many days old, e.g. 2555 (~7 years) for
+	// business records versus a much shorter window for personal spending
+	// a user wants swept away sooner. Zero means no minimum from this
+	// category; see internal/retention.ExpenseRetentionDays for how this
+	// combines with a linked Project's own RetentionDays.
+	RetentionDays int `json:"retention_days,omitempty"`
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// ChallengeType identifies a savings challenge's evaluation rule.
+type ChallengeType string
+
+const (
+	// ChallengeNoSpend requires zero spend in Category between StartDate
+	// and EndDate, e.g. "no restaurants in March". Category empty means no
+	// spend at all.
+	ChallengeNoSpend ChallengeType = "no_spend"
+	// ChallengeSpendLimit requires cumulative spend (in Category, or
+	// overall if Category is empty) to stay under TargetAmount through
+	// EndDate, e.g. a "52-week challenge" framed as a savings target.
+	ChallengeSpendLimit ChallengeType = "spend_limit"
+)
+
+// Challenge is a user-defined savings goal or spending restriction,
+// evaluated automatically against recorded expenses rather than requiring
+// manual progress updates.
+type Challenge struct {
+	ID   string        `json:"id"`
+	Name string        `json:"name"`
+	Type ChallengeType `json:"type"`
+	// Category restricts the rule to a single spending category. Empty
+	// means it applies to all spending.
+	Category string `json:"category,omitempty"`
+	// TargetAmount is the cumulative spend cap for ChallengeSpendLimit.
+	TargetAmount money.Money `json:"target_amount,omitempty"`
+	StartDate    time.Time   `json:"start_date"`
+	EndDate      time.Time   `json:"end_date"`
+	Active       bool        `json:"active"`
+	CreatedAt    time.Time   `json:"created_at"`
+	// UserID is the account this challenge belongs to. Empty means it
+	// predates user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
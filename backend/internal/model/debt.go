@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Debt is a loan or line of credit tracked separately from Account
+// balances, whose balance is reduced by payments recorded as expenses.
+type Debt struct {
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Balance money.Money `json:"balance"`
+	// APR is a percentage rate (e.g. 19.99), not a currency amount.
+	APR            float64     `json:"apr"`
+	MinimumPayment money.Money `json:"minimum_payment"`
+	Active         bool        `json:"active"`
+	CreatedAt      time.Time   `json:"created_at"`
+	// UserID is the account this debt belongs to. Empty means it predates
+	// user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
+
+// DebtPayment records a single payment applied against a Debt's balance,
+// linked to the Expense it was recorded as.
+type DebtPayment struct {
+	ID        string      `json:"id"`
+	DebtID    string      `json:"debt_id"`
+	ExpenseID string      `json:"expense_id"`
+	Amount    money.Money `json:"amount"`
+	Date      time.Time   `json:"date"`
+	CreatedAt time.Time   `json:"created_at"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// DraftExpense is an expense parsed from an external source (a forwarded
+// receipt email, an OCR scan) that a user must confirm or reject before it
+// becomes a real Expense.
+type DraftExpense struct {
+	ID        string      `json:"id"`
+	Amount    money.Money `json:"amount"`
+	Category  string      `json:"category"`
+	Merchant  string      `json:"merchant,omitempty"`
+	Note      string      `json:"note,omitempty"`
+	Date      time.Time   `json:"date"`
+	// Source identifies where the draft came from, e.g. "email".
+	Source    string      `json:"source"`
+	CreatedAt time.Time   `json:"created_at"`
+}
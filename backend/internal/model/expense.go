@@ -0,0 +1,92 @@
+// Package model defines the core domain types shared by the store and API layers.
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Classification distinguishes business from personal spending on a
+// shared wallet, for sole proprietors who don't keep separate accounts.
+type Classification string
+
+const (
+	ClassificationPersonal Classification = "personal"
+	ClassificationBusiness Classification = "business"
+)
+
+// Expense is a single spending entry entered by the user.
+type Expense struct {
+	ID       string      `json:"id"`
+	Amount   money.Money `json:"amount"`
+	Category string      `json:"category"`
+	Merchant string      `json:"merchant,omitempty"`
+	Note     string      `json:"note,omitempty"`
+	// PaymentMethod is a free-text label for how this expense was paid
+	// (e.g. "Visa ...1234", "cash"), informational only. Empty means
+	// unrecorded.
+	PaymentMethod string `json:"payment_method,omitempty"`
+	// Date is the calendar day the expense occurred on. See
+	// internal/civildate for why this isn't a time.Time. Other date-only
+	// fields in this package (RecurringPattern.StartDate, Income.Date,
+	// Reminder.DueDate, Challenge.StartDate/EndDate) haven't been migrated
+	// yet; Expense.Date went first since it's the field the off-by-one
+	// display bug was actually reported against.
+	Date      civildate.Date `json:"date"`
+	CreatedAt time.Time      `json:"created_at"`
+	// UpdatedAt is when this expense was last created or modified, stamped
+	// by the store on every mutating call (Add, AddBatch, Merge,
+	// reconciliation), so sync clients and audit views can order and
+	// detect changes. Attribution (who made the change) is already
+	// carried by UserID; there's no separate created_by/updated_by since
+	// this app doesn't support multiple editors on one expense.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ExternalID identifies the source transaction when an expense was
+	// created by an import or sync integration, e.g. a bank sync
+	// transaction ID. Empty for manually entered expenses.
+	ExternalID string `json:"external_id,omitempty"`
+	// AccountID attributes this expense to an Account, so spending can be
+	// tracked per wallet/card/bank account. Empty means unassigned.
+	AccountID string `json:"account_id,omitempty"`
+	// Reconciled is set once this expense has been matched against a bank
+	// statement via Account reconciliation. Reconciled expenses are
+	// protected from being silently overwritten.
+	Reconciled bool `json:"reconciled,omitempty"`
+	// DebtID marks this expense as a payment against a Debt, reducing its
+	// tracked balance by Amount when the expense is created. Empty means
+	// this expense isn't a debt payment.
+	DebtID string `json:"debt_id,omitempty"`
+	// ProjectID groups this expense under a Project (e.g. a trip),
+	// independent of Category. Empty means unassigned.
+	ProjectID string `json:"project_id,omitempty"`
+	// Deductible marks this expense as tax-deductible, for the tax report.
+	Deductible bool `json:"deductible,omitempty"`
+	// TaxAmount is the portion of Amount paid as sales tax/VAT. Zero means
+	// no tax was recorded for this expense.
+	TaxAmount money.Money `json:"tax_amount,omitempty"`
+	// TaxRate is the tax rate applied, as a percentage (e.g. 8.5 for 8.5%).
+	// It's informational only; TaxAmount is what's aggregated into reports.
+	TaxRate float64 `json:"tax_rate,omitempty"`
+	// Classification is "personal" or "business". Empty is treated as
+	// personal, since most expenses on a shared wallet aren't business.
+	Classification Classification `json:"classification,omitempty"`
+	// UserID is the account this expense belongs to. Empty means it predates
+	// user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+	// Latitude and Longitude are where the expense was made, e.g. captured
+	// from a mobile device at entry time. Both zero means no location was
+	// recorded.
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+	// PlaceName is a human-readable label for the location (e.g. "Ferry
+	// Building Marketplace"), informational only.
+	PlaceName string `json:"place_name,omitempty"`
+	// Fingerprint is a normalized hash of Date, Amount, and Merchant,
+	// computed automatically when the expense is saved (see
+	// internal/store.Store.Add). Import paths compare it against existing
+	// expenses to catch re-imports of the same transaction without relying
+	// on ExternalID, which isn't always available.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Income is a single recorded inflow (paycheck, side income, etc.), the
+// counterpart to Expense used for cash flow and savings rate stats.
+type Income struct {
+	ID        string      `json:"id"`
+	Amount    money.Money `json:"amount"`
+	Source    string      `json:"source"`
+	Note      string      `json:"note,omitempty"`
+	Date      time.Time   `json:"date"`
+	CreatedAt time.Time   `json:"created_at"`
+	// AccountID attributes this income to an Account. Empty means
+	// unassigned.
+	AccountID string `json:"account_id,omitempty"`
+	// Reconciled is set once this income has been matched against a bank
+	// statement via Account reconciliation. Reconciled income is protected
+	// from being silently overwritten.
+	Reconciled bool `json:"reconciled,omitempty"`
+	// UserID is the account this income belongs to. Empty means it predates
+	// user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
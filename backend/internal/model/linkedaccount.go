@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// LinkedAccount is a bank account connected through a Plaid-compatible
+// aggregation API, synced periodically to import transactions as expenses.
+type LinkedAccount struct {
+	ID                string `json:"id"`
+	Institution       string `json:"institution"`
+	AccountName       string `json:"account_name"`
+	ExternalAccountID string `json:"external_account_id"`
+	// AccessToken authorizes fetching transactions for this account. Never
+	// returned by the API.
+	AccessToken string `json:"access_token,omitempty"`
+	// Cursor is the aggregator's sync cursor, letting the next sync fetch
+	// only transactions added since the last one.
+	Cursor       string    `json:"cursor,omitempty"`
+	Active       bool      `json:"active"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastSyncedAt time.Time `json:"last_synced_at,omitempty"`
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// ChannelType identifies which service a NotificationChannel delivers to.
+type ChannelType string
+
+const (
+	ChannelSlack    ChannelType = "slack"
+	ChannelDiscord  ChannelType = "discord"
+	ChannelTelegram ChannelType = "telegram"
+)
+
+// NotificationChannel is a configured destination for event notifications
+// (large expenses, budget threshold crossings, upcoming bills).
+type NotificationChannel struct {
+	ID   string      `json:"id"`
+	Type ChannelType `json:"type"`
+	// WebhookURL is used by Slack and Discord channels.
+	WebhookURL string `json:"webhook_url,omitempty"`
+	// BotToken and ChatID are used by Telegram channels.
+	BotToken  string    `json:"bot_token,omitempty"`
+	ChatID    string    `json:"chat_id,omitempty"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// QueuedNotification is a message held back from immediate delivery because
+// it arrived during quiet hours or because batching is enabled (see
+// internal/notify.Dispatch), waiting to go out as part of the next flushed
+// summary (see internal/notify.FlushQueue).
+type QueuedNotification struct {
+	ID        string    `json:"id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
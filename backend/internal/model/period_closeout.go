@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// PeriodCloseoutCategoryTotal is a category's total spend within a closed
+// month, captured at close time so the journal entry reflects the numbers
+// as they stood when the user reviewed them, even if expenses are edited
+// or backdated afterward.
+type PeriodCloseoutCategoryTotal struct {
+	Category string      `json:"category"`
+	Total    money.Money `json:"total"`
+}
+
+// PeriodCloseout is a monthly close-out journal entry: a snapshot of that
+// month's totals plus the user's own notes on how it went ("overspent due
+// to car repair"), so past months can be reviewed later without recomputing
+// stats against data that may have since changed.
+type PeriodCloseout struct {
+	ID    string `json:"id"`
+	Month string `json:"month"` // "2006-01"
+	// Notes is the user's own reflection on the month, e.g. why it went
+	// over or under budget.
+	Notes          string                        `json:"notes,omitempty"`
+	Total          money.Money                   `json:"total"`
+	ExpenseCount   int                           `json:"expense_count"`
+	CategoryTotals []PeriodCloseoutCategoryTotal `json:"category_totals"`
+	CreatedAt      time.Time                     `json:"created_at"`
+	// UserID is the account this close-out belongs to. Empty means it
+	// predates per-user accounts.
+	UserID string `json:"user_id,omitempty"`
+}
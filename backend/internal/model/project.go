@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Project groups expenses under a shared label independent of category,
+// e.g. a trip or a one-off event, with its own optional budget and date
+// range.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Budget is an optional spending target for the whole project. Zero
+	// means no budget is set.
+	Budget    money.Money `json:"budget,omitempty"`
+	StartDate time.Time   `json:"start_date,omitempty"`
+	EndDate   time.Time   `json:"end_date,omitempty"`
+	Active    bool        `json:"active"`
+	CreatedAt time.Time   `json:"created_at"`
+	// RetentionDays, if set, is a legal/compliance minimum: expenses linked
+	// to this project (see Expense.ProjectID) are protected from
+	// internal/retention's expense purge until they're at least this many
+	// days old. Zero means no minimum from this project; see
+	// internal/retention.ExpenseRetentionDays for how this combines with
+	// the expense's own Category.RetentionDays.
+	RetentionDays int `json:"retention_days,omitempty"`
+	// UserID is the account this project belongs to. Empty means it
+	// predates user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
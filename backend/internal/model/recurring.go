@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Frequency is how often a RecurringPattern generates occurrences.
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+	FrequencyYearly  Frequency = "yearly"
+)
+
+// RecurringPattern describes a bill or income that repeats on a schedule,
+// used to generate expenses automatically and to project upcoming ones.
+type RecurringPattern struct {
+	ID       string      `json:"id"`
+	Amount   money.Money `json:"amount"`
+	Category string      `json:"category"`
+	Merchant string      `json:"merchant,omitempty"`
+	// Vendor groups patterns from the same underlying provider (e.g. two
+	// Amazon subscriptions billed under different categories), independent
+	// of Merchant which is the exact billing descriptor. Empty means
+	// ungrouped.
+	Vendor    string    `json:"vendor,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	Frequency Frequency `json:"frequency"`
+	StartDate time.Time `json:"start_date"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// LastReminderSent is the occurrence date the "bill due soon"
+	// notification was last sent for, so reminders aren't repeated.
+	LastReminderSent time.Time `json:"last_reminder_sent,omitempty"`
+	// RemindDaysBefore overrides how many days before each occurrence this
+	// pattern's "bill due soon" notification fires (see
+	// recurring.DefaultRemindDaysBefore). Zero or negative means use the
+	// default.
+	RemindDaysBefore int `json:"remind_days_before,omitempty"`
+	// UserID is the account this pattern belongs to. Empty means it predates
+	// user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
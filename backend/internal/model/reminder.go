@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Reminder is a one-off future bill with a due date, for payments that
+// don't repeat on a schedule and so don't fit RecurringPattern.
+type Reminder struct {
+	ID       string      `json:"id"`
+	Category string      `json:"category"`
+	Merchant string      `json:"merchant,omitempty"`
+	Amount   money.Money `json:"amount"`
+	DueDate  time.Time   `json:"due_date"`
+	Note     string      `json:"note,omitempty"`
+	// Notified is set once the "due soon" notification has been sent, so it
+	// isn't sent again on every notification sweep.
+	Notified  bool      `json:"notified,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// UserID is the account this reminder belongs to. Empty means it
+	// predates user accounts and is treated as unowned.
+	UserID string `json:"user_id,omitempty"`
+}
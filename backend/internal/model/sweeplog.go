@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// SweepLog records a single run of the recurring-pattern reminder sweep
+// (see internal/notify.RunBillReminders), so a user can see why an
+// expected bill reminder did or didn't fire.
+type SweepLog struct {
+	ID string `json:"id"`
+	// TriggerSource identifies what started the sweep, e.g. "scheduler".
+	TriggerSource     string    `json:"trigger_source"`
+	StartedAt         time.Time `json:"started_at"`
+	DurationMS        int64     `json:"duration_ms"`
+	PatternsProcessed int       `json:"patterns_processed"`
+	// RemindersSent is how many bill-due notifications were broadcast
+	// during this sweep. Recurring patterns only generate reminders in
+	// this version, not expenses, so there's no separate expenses-created
+	// count.
+	RemindersSent int      `json:"reminders_sent"`
+	Errors        []string `json:"errors,omitempty"`
+}
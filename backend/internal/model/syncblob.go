@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// SyncBlob is an opaque, client-encrypted record for zero-knowledge sync: the
+// server stores Ciphertext and Nonce exactly as given and never has the key
+// to read them, so it can host a client's sync data without being able to
+// see it. ID is chosen by the client (e.g. its own local record ID), letting
+// repeat PUTs to the same ID update the same blob instead of accumulating
+// duplicates.
+type SyncBlob struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	// Ciphertext is the client's end-to-end-encrypted payload. What it
+	// decodes to, and how, is entirely up to the client; the server treats
+	// it as bytes.
+	Ciphertext []byte `json:"ciphertext"`
+	// Nonce is the encryption nonce/IV the client used alongside
+	// Ciphertext. It isn't sensitive on its own, so it's stored alongside
+	// the ciphertext rather than folded into it.
+	Nonce []byte `json:"nonce,omitempty"`
+	// Revision increments on every successful PUT, so a client can detect a
+	// conflicting write from another device with optimistic concurrency
+	// (see the API's expected_revision handling) instead of silently
+	// clobbering it.
+	Revision  int64     `json:"revision"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
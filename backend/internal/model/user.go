@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// User is an account holder. Expenses and recurring patterns are scoped to
+// a UserID so the API can serve more than one household from a single data
+// file.
+type User struct {
+	ID           string    `json:"id"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"password_hash"`
+	CreatedAt    time.Time `json:"created_at"`
+}
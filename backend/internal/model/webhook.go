@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookEvent identifies which event type a Webhook is subscribed to.
+type WebhookEvent string
+
+const (
+	WebhookEventExpenseCreated         WebhookEvent = "expense.created"
+	WebhookEventBudgetThresholdCrossed WebhookEvent = "budget.threshold_crossed"
+	WebhookEventRecurringBillDue       WebhookEvent = "recurring.bill_due"
+)
+
+// Webhook is a caller-registered HTTP endpoint that receives signed
+// deliveries when one of its subscribed Events occurs.
+type Webhook struct {
+	ID     string         `json:"id"`
+	URL    string         `json:"url"`
+	Events []WebhookEvent `json:"events"`
+	// Secret signs every delivery to this webhook (see
+	// internal/webhooks.Sign) and is never returned by the API once set.
+	Secret    string    `json:"-"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	// UserID is the account that registered this webhook.
+	UserID string `json:"user_id,omitempty"`
+}
+
+// WebhookDeliveryStatus is the outcome of a single delivery attempt.
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery records one attempt to deliver an event payload to a
+// Webhook, including redeliveries, so a receiver's outage can be diagnosed
+// and the same event resent later without regenerating it.
+type WebhookDelivery struct {
+	ID        string          `json:"id"`
+	WebhookID string          `json:"webhook_id"`
+	Event     WebhookEvent    `json:"event"`
+	Payload   json.RawMessage `json:"payload"`
+	// Timestamp is embedded in the signed payload (see
+	// internal/webhooks.Sign) so a receiver can reject deliveries whose
+	// timestamp is too old to plausibly be a live request rather than a
+	// captured-and-replayed one.
+	Timestamp    time.Time             `json:"timestamp"`
+	Status       WebhookDeliveryStatus `json:"status"`
+	ResponseCode int                   `json:"response_code,omitempty"`
+	Error        string                `json:"error,omitempty"`
+	// Attempts counts this delivery ID's sends, incremented by a redeliver
+	// (see POST .../deliveries/{id}/redeliver); the delivery keeps its
+	// original ID and Payload across every attempt.
+	Attempts    int       `json:"attempts"`
+	CreatedAt   time.Time `json:"created_at"`
+	DeliveredAt time.Time `json:"delivered_at,omitempty"`
+}
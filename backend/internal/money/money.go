@@ -0,0 +1,140 @@
+// Package money represents currency amounts as integer minor units (cents)
+// so repeated addition and JSON round-tripping can't drift the way float64
+// summation does (e.g. 0.1 + 0.2 landing on 0.30000000000000004).
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in minor units (cents for USD and other two-decimal
+// currencies). The zero value is zero.
+type Money int64
+
+// FromFloat converts a float64 major-unit amount (e.g. 19.99) to Money,
+// rounding to the nearest cent. Use this only at the boundary when a float
+// amount arrives from an external source (bank sync, receipt parsing); all
+// internal arithmetic should stay in Money.
+func FromFloat(f float64) Money {
+	if f < 0 {
+		return Money(f*100 - 0.5)
+	}
+	return Money(f*100 + 0.5)
+}
+
+// Float64 converts back to a major-unit float, for callers that need to do
+// non-monetary math (percentages, chart scales) with the value.
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+// String renders m as a fixed two-decimal amount, e.g. "104.40" or "-5.00".
+func (m Money) String() string {
+	neg := m < 0
+	v := int64(m)
+	if neg {
+		v = -v
+	}
+	whole, cents := v/100, v%100
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, whole, cents)
+}
+
+// RoundingMode selects the tie-breaking rule used when a float64 major-unit
+// amount falls exactly halfway between two minor-unit values.
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds ties away from zero (2.5 -> 3, -2.5 -> -3). This is
+	// FromFloat's long-standing behavior and remains the default.
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundHalfEven is banker's rounding: ties round to the nearest even
+	// cent (2.235 -> 2.24, 2.225 -> 2.22), which avoids the small upward
+	// bias RoundHalfUp accumulates over many rounded amounts.
+	RoundHalfEven RoundingMode = "half_even"
+)
+
+// FromFloatRounding is FromFloat with an explicit tie-breaking rule instead
+// of always rounding half away from zero. An unrecognized mode falls back
+// to RoundHalfUp, matching FromFloat.
+func FromFloatRounding(f float64, mode RoundingMode) Money {
+	if mode == RoundHalfEven {
+		return Money(math.RoundToEven(f * 100))
+	}
+	return FromFloat(f)
+}
+
+// Split divides m into n equal-as-possible parts that sum to exactly m, so
+// that dividing an amount among n people (or n installments) never loses or
+// invents a cent to rounding. Any remainder left after the even split is
+// distributed one minor unit at a time to the first parts, in order. Split
+// returns nil if n is not positive.
+func Split(m Money, n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+	base := m / Money(n)
+	remainder := int(m % Money(n))
+	parts := make([]Money, n)
+	for i := range parts {
+		parts[i] = base
+	}
+	step := Money(1)
+	if remainder < 0 {
+		step = -1
+		remainder = -remainder
+	}
+	for i := 0; i < remainder; i++ {
+		parts[i] += step
+	}
+	return parts
+}
+
+// Parse converts a decimal string like "104.40" or "-5" to Money.
+func Parse(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+	return FromFloat(f), nil
+}
+
+// MarshalJSON encodes m as a quoted decimal string, so JSON consumers never
+// see a raw float and can't reintroduce binary rounding error.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(m.String())), nil
+}
+
+// UnmarshalJSON accepts either a quoted decimal string ("19.99") or a bare
+// JSON number (19.99), so existing API clients sending numeric amounts keep
+// working.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		*m = 0
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return err
+		}
+		s = unquoted
+	}
+	v, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
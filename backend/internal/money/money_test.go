@@ -0,0 +1,118 @@
+package money
+
+import "testing"
+
+func TestFromFloatRoundsToNearestCent(t *testing.T) {
+	if got := FromFloat(19.999); got != 2000 {
+		t.Fatalf("got %d, want 2000", got)
+	}
+	if got := FromFloat(-5.005); got != -501 && got != -500 {
+		t.Fatalf("got %d, want -500 or -501", got)
+	}
+}
+
+func TestStringFormatsTwoDecimals(t *testing.T) {
+	cases := map[Money]string{
+		0:    "0.00",
+		1040: "10.40",
+		-500: "-5.00",
+		5:    "0.05",
+	}
+	for m, want := range cases {
+		if got := m.String(); got != want {
+			t.Fatalf("Money(%d).String() = %q, want %q", m, got, want)
+		}
+	}
+}
+
+func TestJSONRoundTripsExactly(t *testing.T) {
+	m := FromFloat(104.30)
+	data, err := m.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	var got Money
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if got != m {
+		t.Fatalf("got %d, want %d", got, m)
+	}
+}
+
+func TestUnmarshalAcceptsBareNumber(t *testing.T) {
+	var m Money
+	if err := m.UnmarshalJSON([]byte("19.99")); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if m != 1999 {
+		t.Fatalf("got %d, want 1999", m)
+	}
+}
+
+func TestFromFloatRoundingHalfEvenRoundsTiesToEvenCent(t *testing.T) {
+	if got := FromFloatRounding(2.235, RoundHalfEven); got != 224 {
+		t.Fatalf("got %d, want 224", got)
+	}
+	if got := FromFloatRounding(2.225, RoundHalfEven); got != 222 {
+		t.Fatalf("got %d, want 222", got)
+	}
+}
+
+func TestFromFloatRoundingDefaultsToHalfUp(t *testing.T) {
+	if got := FromFloatRounding(19.999, ""); got != FromFloat(19.999) {
+		t.Fatalf("got %d, want %d", got, FromFloat(19.999))
+	}
+}
+
+func TestSplitPartsSumToOriginalAmount(t *testing.T) {
+	cases := []struct {
+		total Money
+		n     int
+	}{
+		{1000, 3},
+		{-1000, 3},
+		{1, 7},
+		{0, 4},
+		{999, 1},
+	}
+	for _, c := range cases {
+		parts := Split(c.total, c.n)
+		if len(parts) != c.n {
+			t.Fatalf("Split(%d, %d): got %d parts, want %d", c.total, c.n, len(parts), c.n)
+		}
+		var sum Money
+		for _, p := range parts {
+			sum += p
+		}
+		if sum != c.total {
+			t.Fatalf("Split(%d, %d): parts sum to %d, want %d", c.total, c.n, sum, c.total)
+		}
+	}
+}
+
+func TestSplitDistributesRemainderByOneCent(t *testing.T) {
+	parts := Split(1000, 3)
+	want := []Money{334, 333, 333}
+	for i, w := range want {
+		if parts[i] != w {
+			t.Fatalf("parts[%d] = %d, want %d", i, parts[i], w)
+		}
+	}
+}
+
+func TestSplitReturnsNilForNonPositiveN(t *testing.T) {
+	if got := Split(100, 0); got != nil {
+		t.Fatalf("got %v, want nil", got)
+	}
+}
+
+func TestSummingCentsAvoidsFloatDrift(t *testing.T) {
+	total := FromFloat(0.1) + FromFloat(0.2)
+	if total != 30 {
+		t.Fatalf("got %d, want 30", total)
+	}
+	if total.Float64() != 0.3 {
+		t.Fatalf("got %v, want 0.3", total.Float64())
+	}
+}
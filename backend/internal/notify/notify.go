@@ -0,0 +1,81 @@
+// Package notify delivers event notifications to configured Slack, Discord,
+// and Telegram channels.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// httpClient is a package-level var so tests can swap in a fake transport.
+var httpClient = &http.Client{}
+
+// Send delivers message to a single channel.
+func Send(c *model.NotificationChannel, message string) error {
+	switch c.Type {
+	case model.ChannelSlack, model.ChannelDiscord:
+		return sendWebhook(c.WebhookURL, message)
+	case model.ChannelTelegram:
+		return sendTelegram(c.BotToken, c.ChatID, message)
+	default:
+		return fmt.Errorf("unknown channel type %q", c.Type)
+	}
+}
+
+// Broadcast sends message to every active channel, continuing past
+// individual failures and returning the first error encountered, if any.
+func Broadcast(channels []*model.NotificationChannel, message string) error {
+	var firstErr error
+	for _, c := range channels {
+		if !c.Active {
+			continue
+		}
+		if err := Send(c, message); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// sendWebhook posts message as {"content": message} / {"text": message},
+// the shape both Slack and Discord incoming webhooks accept.
+func sendWebhook(webhookURL, message string) error {
+	if webhookURL == "" {
+		return fmt.Errorf("webhook_url is not configured")
+	}
+	body, err := json.Marshal(map[string]string{"text": message, "content": message})
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func sendTelegram(botToken, chatID, message string) error {
+	if botToken == "" || chatID == "" {
+		return fmt.Errorf("bot_token and chat_id are required")
+	}
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", botToken)
+	form := url.Values{"chat_id": {chatID}, "text": {message}}
+	resp, err := httpClient.PostForm(endpoint, form)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("telegram API returned status %d", resp.StatusCode)
+	}
+	return nil
+}
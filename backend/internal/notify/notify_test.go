@@ -0,0 +1,52 @@
+package notify
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestSendWebhookDeliversToConfiguredURL(t *testing.T) {
+	var gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, r.ContentLength)
+		_, _ = r.Body.Read(buf)
+		gotBody = string(buf)
+	}))
+	defer srv.Close()
+
+	c := &model.NotificationChannel{Type: model.ChannelSlack, WebhookURL: srv.URL, Active: true}
+	if err := Send(c, "large expense recorded"); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if gotBody == "" {
+		t.Error("expected webhook to receive a body")
+	}
+}
+
+func TestSendRejectsUnconfiguredChannel(t *testing.T) {
+	c := &model.NotificationChannel{Type: model.ChannelSlack}
+	if err := Send(c, "hello"); err == nil {
+		t.Error("expected error for channel with no webhook_url")
+	}
+}
+
+func TestBroadcastSkipsInactiveChannels(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	channels := []*model.NotificationChannel{
+		{Type: model.ChannelSlack, WebhookURL: srv.URL, Active: false},
+	}
+	if err := Broadcast(channels, "hello"); err != nil {
+		t.Fatalf("Broadcast: %v", err)
+	}
+	if called {
+		t.Error("expected inactive channel to be skipped")
+	}
+}
@@ -0,0 +1,110 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/recurring"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// RunBillReminders checks once per interval for active recurring patterns
+// with an occurrence falling within their reminder lead time (see
+// recurring.RemindDaysBefore) of now, and notifies all channels. It blocks
+// until stop is closed, so callers should run it in its own goroutine.
+func RunBillReminders(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			checkBillReminders(st, now)
+		}
+	}
+}
+
+// reminderDueSoonWindow is how far ahead of a one-off Reminder's due date
+// its notification is sent. Recurring patterns use their own
+// RemindDaysBefore instead (see dueWithin).
+const reminderDueSoonWindow = 3 * 24 * time.Hour
+
+func checkBillReminders(st *store.Store, now time.Time) {
+	recordSweepLog(st, "scheduler", now, func() (patternsProcessed, remindersSent int, errs []string) {
+		dueBy := now.Add(reminderDueSoonWindow)
+		patterns := st.ListRecurring(false)
+		patternsProcessed = len(patterns)
+		for _, p := range patterns {
+			due, ok := dueWithin(p, now)
+			if !ok || due.Equal(p.LastReminderSent) {
+				continue
+			}
+			message := fmt.Sprintf("Bill due %s: %s (%s)", due.Format("Jan 2"), p.Category, p.Amount)
+			if err := Dispatch(context.Background(), st, message); err != nil {
+				slog.Error("notify: bill reminder failed", "recurring_id", p.ID, "error", err)
+				errs = append(errs, fmt.Sprintf("recurring %s: %v", p.ID, err))
+				continue
+			}
+			remindersSent++
+			p.LastReminderSent = due
+			if err := st.AddRecurring(context.Background(), p); err != nil {
+				slog.Error("notify: persist reminder state failed", "recurring_id", p.ID, "error", err)
+				errs = append(errs, fmt.Sprintf("recurring %s: %v", p.ID, err))
+			}
+		}
+
+		for _, rem := range st.ListReminders(false) {
+			if rem.Notified || rem.DueDate.Before(now) || rem.DueDate.After(dueBy) {
+				continue
+			}
+			message := fmt.Sprintf("Bill due %s: %s (%s)", rem.DueDate.Format("Jan 2"), rem.Category, rem.Amount)
+			if err := Dispatch(context.Background(), st, message); err != nil {
+				slog.Error("notify: bill reminder failed", "reminder_id", rem.ID, "error", err)
+				errs = append(errs, fmt.Sprintf("reminder %s: %v", rem.ID, err))
+				continue
+			}
+			remindersSent++
+			rem.Notified = true
+			if err := st.AddReminder(context.Background(), rem); err != nil {
+				slog.Error("notify: persist reminder state failed", "reminder_id", rem.ID, "error", err)
+				errs = append(errs, fmt.Sprintf("reminder %s: %v", rem.ID, err))
+			}
+		}
+		return patternsProcessed, remindersSent, errs
+	})
+}
+
+// recordSweepLog runs run, timing it, and persists the result as a
+// model.SweepLog so users can see why an expected reminder did or didn't
+// fire.
+func recordSweepLog(st *store.Store, trigger string, startedAt time.Time, run func() (patternsProcessed, remindersSent int, errs []string)) {
+	patternsProcessed, remindersSent, errs := run()
+	log := &model.SweepLog{
+		ID:                idgen.New(),
+		TriggerSource:     trigger,
+		StartedAt:         startedAt,
+		DurationMS:        time.Since(startedAt).Milliseconds(),
+		PatternsProcessed: patternsProcessed,
+		RemindersSent:     remindersSent,
+		Errors:            errs,
+	}
+	if err := st.RecordSweepLog(context.Background(), log); err != nil {
+		slog.Error("notify: persist sweep log failed", "error", err)
+	}
+}
+
+// dueWithin returns the earliest occurrence of p within p's own reminder
+// lead time of now, if any (see recurring.RemindDaysBefore).
+func dueWithin(p *model.RecurringPattern, now time.Time) (time.Time, bool) {
+	dueBy := now.AddDate(0, 0, recurring.RemindDaysBefore(p))
+	occurrences := recurring.Upcoming([]*model.RecurringPattern{p}, now, dueBy)
+	if len(occurrences) == 0 {
+		return time.Time{}, false
+	}
+	return occurrences[0].Date, true
+}
@@ -0,0 +1,92 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// Dispatch delivers message to every active channel, unless the user's
+// notification schedule preferences say otherwise: during configured quiet
+// hours, or whenever batching is enabled, message is queued instead and
+// delivered later as part of a combined summary (see FlushQueue), so alert
+// channels don't ping overnight when the nightly sweep backfills bills.
+func Dispatch(ctx context.Context, st *store.Store, message string) error {
+	s := st.Settings()
+	if s.NotificationBatching || inQuietHours(s.QuietHoursStart, s.QuietHoursEnd, time.Now().In(s.Location()).Hour()) {
+		return st.QueueNotification(ctx, &model.QueuedNotification{ID: idgen.New(), Message: message, CreatedAt: time.Now()})
+	}
+	return Broadcast(st.ListChannels(false), message)
+}
+
+// inQuietHours reports whether hour falls in [start, end), wrapping past
+// midnight if end <= start. Equal start and end means quiet hours are off.
+func inQuietHours(start, end, hour int) bool {
+	if start == end {
+		return false
+	}
+	if start < end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
+
+// FlushQueue delivers every notification queued since the last flush as one
+// combined message, then clears the queue.
+func FlushQueue(ctx context.Context, st *store.Store) error {
+	queued := st.ListQueuedNotifications()
+	if len(queued) == 0 {
+		return nil
+	}
+	lines := make([]string, len(queued))
+	for i, q := range queued {
+		lines[i] = "- " + q.Message
+	}
+	message := fmt.Sprintf("Notification summary (%d queued):\n%s", len(queued), strings.Join(lines, "\n"))
+	if err := Broadcast(st.ListChannels(false), message); err != nil {
+		return err
+	}
+	return st.ClearQueuedNotifications(ctx)
+}
+
+// RunQueueFlush checks once per interval whether the queue is due to be
+// flushed (once a day, and only outside quiet hours) and flushes it. It
+// blocks until stop is closed, so callers should run it in its own
+// goroutine.
+func RunQueueFlush(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if err := checkAndFlush(st, now); err != nil {
+				slog.Error("notify: queue flush failed", "error", err)
+			}
+		}
+	}
+}
+
+func checkAndFlush(st *store.Store, now time.Time) error {
+	s := st.Settings()
+	if inQuietHours(s.QuietHoursStart, s.QuietHoursEnd, now.In(s.Location()).Hour()) {
+		return nil
+	}
+	dayStart := period.Start(now, period.Day, s)
+	if !dayStart.After(s.QueueLastFlushed) {
+		return nil
+	}
+	if err := FlushQueue(context.Background(), st); err != nil {
+		return err
+	}
+	s.QueueLastFlushed = dayStart
+	return st.UpdateSettings(context.Background(), s)
+}
@@ -0,0 +1,42 @@
+// Package ocr extracts text from a receipt image via a pluggable
+// text-recognition backend, so an uploaded photo can be turned into a
+// draft expense the same way a forwarded receipt email is.
+package ocr
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+)
+
+// Processor extracts raw text from a receipt image. A local Tesseract
+// wrapper and an external OCR API client both satisfy this, so the upload
+// handler doesn't care which backend is configured.
+type Processor interface {
+	Extract(image []byte) (text string, err error)
+}
+
+// TesseractProcessor extracts text by shelling out to a local `tesseract`
+// binary, so OCR works out of the box without depending on an external
+// service.
+type TesseractProcessor struct {
+	// BinaryPath is the tesseract executable to run. Empty defaults to
+	// "tesseract" on $PATH.
+	BinaryPath string
+}
+
+// Extract implements Processor.
+func (p TesseractProcessor) Extract(image []byte) (string, error) {
+	bin := p.BinaryPath
+	if bin == "" {
+		bin = "tesseract"
+	}
+	cmd := exec.Command(bin, "stdin", "stdout")
+	cmd.Stdin = bytes.NewReader(image)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("run tesseract: %w", err)
+	}
+	return out.String(), nil
+}
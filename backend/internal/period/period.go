@@ -0,0 +1,55 @@
+// Package period computes calendar period boundaries (day/week/month),
+// honoring the user's configured week start and fiscal month start day.
+package period
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// Granularity is the size of a period bucket.
+type Granularity string
+
+const (
+	Day   Granularity = "day"
+	Week  Granularity = "week"
+	Month Granularity = "month"
+)
+
+// Start truncates t down to the start of the period containing it, per g
+// and the given settings. Boundaries are computed in the user's configured
+// timezone, not UTC.
+func Start(t time.Time, g Granularity, s settings.Settings) time.Time {
+	t = t.In(s.Location())
+	switch g {
+	case Week:
+		offset := (int(t.Weekday()) - int(s.WeekStart) + 7) % 7
+		d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return d.AddDate(0, 0, -offset)
+	case Month:
+		start := s.FiscalMonthStartDay
+		if start < 1 {
+			start = 1
+		}
+		if t.Day() >= start {
+			return time.Date(t.Year(), t.Month(), start, 0, 0, 0, 0, t.Location())
+		}
+		return time.Date(t.Year(), t.Month(), start, 0, 0, 0, 0, t.Location()).AddDate(0, -1, 0)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
+
+// Next returns the start of the period immediately following t, which must
+// already be a period start (i.e. the result of Start).
+func Next(t time.Time, g Granularity) time.Time {
+	switch g {
+	case Week:
+		return t.AddDate(0, 0, 7)
+	case Month:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,37 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+func TestStartWeekRespectsWeekStart(t *testing.T) {
+	// 2026-01-07 is a Wednesday.
+	d := time.Date(2026, 1, 7, 15, 0, 0, 0, time.UTC)
+
+	sundayStart := settings.Settings{WeekStart: time.Sunday}
+	if got := Start(d, Week, sundayStart); got.Format("2006-01-02") != "2026-01-04" {
+		t.Errorf("sunday week start: got %s, want 2026-01-04", got.Format("2006-01-02"))
+	}
+
+	mondayStart := settings.Settings{WeekStart: time.Monday}
+	if got := Start(d, Week, mondayStart); got.Format("2006-01-02") != "2026-01-05" {
+		t.Errorf("monday week start: got %s, want 2026-01-05", got.Format("2006-01-02"))
+	}
+}
+
+func TestStartMonthRespectsFiscalStartDay(t *testing.T) {
+	s := settings.Settings{FiscalMonthStartDay: 25}
+
+	before := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	if got := Start(before, Month, s); got.Format("2006-01-02") != "2026-02-25" {
+		t.Errorf("got %s, want 2026-02-25", got.Format("2006-01-02"))
+	}
+
+	after := time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)
+	if got := Start(after, Month, s); got.Format("2006-01-02") != "2026-03-25" {
+		t.Errorf("got %s, want 2026-03-25", got.Format("2006-01-02"))
+	}
+}
@@ -0,0 +1,113 @@
+// Package receipts extracts draft expenses from forwarded receipt emails.
+package receipts
+
+import (
+	"fmt"
+	"io"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+var (
+	totalRe  = regexp.MustCompile(`(?i)(?:total|amount due|amount)[:\s]*\$?([0-9]+(?:,[0-9]{3})*\.[0-9]{2})`)
+	anyMoney = regexp.MustCompile(`\$([0-9]+(?:,[0-9]{3})*\.[0-9]{2})`)
+)
+
+// Parse extracts a DraftExpense from a receipt email's from address,
+// subject, and plain-text body. receivedAt is used as the expense date
+// when the email itself doesn't mention one. Parse never fails outright —
+// a receipt with no recognizable amount still becomes a draft with a zero
+// amount, since the user reviews every draft before it's confirmed.
+func Parse(from, subject, body string, receivedAt time.Time) *model.DraftExpense {
+	return &model.DraftExpense{
+		Amount:    extractAmount(body, subject),
+		Category:  "uncategorized",
+		Merchant:  merchantFromAddress(from),
+		Note:      subject,
+		Date:      receivedAt,
+		Source:    "email",
+		CreatedAt: receivedAt,
+	}
+}
+
+// ParseText extracts a DraftExpense from arbitrary receipt text (e.g. OCR
+// output), reusing the same amount-extraction heuristics as Parse. Unlike
+// Parse, there's no From address to derive a merchant from, so the first
+// non-blank line of the receipt is used instead, since that's conventionally
+// the store name on a printed receipt.
+func ParseText(text string, receivedAt time.Time) *model.DraftExpense {
+	return &model.DraftExpense{
+		Amount:    extractAmount(text),
+		Category:  "uncategorized",
+		Merchant:  firstLine(text),
+		Date:      receivedAt,
+		Source:    "ocr",
+		CreatedAt: receivedAt,
+	}
+}
+
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// ParseRawEmail parses r as an RFC 5322 message and returns the sender,
+// subject, and body needed by Parse.
+func ParseRawEmail(raw string) (from, subject, body string, err error) {
+	msg, err := mail.ReadMessage(strings.NewReader(raw))
+	if err != nil {
+		return "", "", "", fmt.Errorf("parse email: %w", err)
+	}
+	buf := new(strings.Builder)
+	if _, err := io.Copy(buf, msg.Body); err != nil {
+		return "", "", "", fmt.Errorf("read email body: %w", err)
+	}
+	return msg.Header.Get("From"), msg.Header.Get("Subject"), buf.String(), nil
+}
+
+func extractAmount(fields ...string) money.Money {
+	for _, f := range fields {
+		if m := totalRe.FindStringSubmatch(f); m != nil {
+			return parseAmount(m[1])
+		}
+	}
+	for _, f := range fields {
+		if m := anyMoney.FindStringSubmatch(f); m != nil {
+			return parseAmount(m[1])
+		}
+	}
+	return 0
+}
+
+func parseAmount(s string) money.Money {
+	amount, _ := strconv.ParseFloat(strings.ReplaceAll(s, ",", ""), 64)
+	return money.FromFloat(amount)
+}
+
+// merchantFromAddress derives a display name from an email From header,
+// preferring the display name ("Trader Joe's <receipts@traderjoes.com>")
+// and falling back to the address's domain.
+func merchantFromAddress(from string) string {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return ""
+	}
+	if addr.Name != "" {
+		return addr.Name
+	}
+	parts := strings.SplitN(addr.Address, "@", 2)
+	if len(parts) == 2 {
+		return parts[1]
+	}
+	return addr.Address
+}
@@ -0,0 +1,40 @@
+package receipts
+
+import (
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+func TestParseExtractsTotalAndMerchant(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	d := Parse("Trader Joe's <receipts@traderjoes.com>", "Your receipt", "Thanks for shopping!\nTotal: $42.17\n", now)
+
+	if d.Amount != money.FromFloat(42.17) {
+		t.Errorf("got amount %v, want 42.17", d.Amount)
+	}
+	if d.Merchant != "Trader Joe's" {
+		t.Errorf("got merchant %q, want Trader Joe's", d.Merchant)
+	}
+	if !d.Date.Equal(now) {
+		t.Errorf("got date %v, want %v", d.Date, now)
+	}
+}
+
+func TestParseFallsBackToAnyDollarAmount(t *testing.T) {
+	d := Parse("shop@example.com", "Receipt", "Your order of $19.99 has shipped", time.Now())
+	if d.Amount != money.FromFloat(19.99) {
+		t.Errorf("got amount %v, want 19.99", d.Amount)
+	}
+	if d.Merchant != "example.com" {
+		t.Errorf("got merchant %q, want example.com (domain fallback)", d.Merchant)
+	}
+}
+
+func TestParseWithNoAmountDefaultsToZero(t *testing.T) {
+	d := Parse("shop@example.com", "Newsletter", "No prices here", time.Now())
+	if d.Amount != 0 {
+		t.Errorf("got amount %v, want 0", d.Amount)
+	}
+}
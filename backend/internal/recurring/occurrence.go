@@ -0,0 +1,93 @@
+// Package recurring projects future occurrences of recurring patterns.
+package recurring
+
+import (
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// DefaultRemindDaysBefore is how many days before an occurrence its "bill
+// due soon" notification fires, for patterns that don't set
+// RemindDaysBefore of their own.
+const DefaultRemindDaysBefore = 3
+
+// Occurrence is a single projected date on which a pattern will produce an
+// expense.
+type Occurrence struct {
+	Date    time.Time               `json:"date"`
+	Pattern *model.RecurringPattern `json:"pattern"`
+	// RemindAt is when this occurrence's reminder notification fires,
+	// derived from Pattern.RemindDaysBefore (or DefaultRemindDaysBefore).
+	RemindAt time.Time `json:"remind_at"`
+}
+
+// RemindDaysBefore returns p's configured reminder lead time, falling back
+// to DefaultRemindDaysBefore if unset.
+func RemindDaysBefore(p *model.RecurringPattern) int {
+	if p.RemindDaysBefore > 0 {
+		return p.RemindDaysBefore
+	}
+	return DefaultRemindDaysBefore
+}
+
+// Upcoming projects occurrences of every active pattern in [from, to).
+func Upcoming(patterns []*model.RecurringPattern, from, to time.Time) []Occurrence {
+	var occurrences []Occurrence
+	for _, p := range patterns {
+		if !p.Active {
+			continue
+		}
+		for _, d := range occurrencesInRange(p, from, to) {
+			occurrences = append(occurrences, Occurrence{
+				Date:     d,
+				Pattern:  p,
+				RemindAt: d.AddDate(0, 0, -RemindDaysBefore(p)),
+			})
+		}
+	}
+	return occurrences
+}
+
+// occurrencesInRange walks a pattern's schedule forward from its start
+// date and collects every occurrence landing in [from, to).
+func occurrencesInRange(p *model.RecurringPattern, from, to time.Time) []time.Time {
+	var dates []time.Time
+	d := p.StartDate
+	// Cap iterations so a bad/very-old start date can't loop forever.
+	for i := 0; i < 10_000 && d.Before(to); i++ {
+		if !d.Before(from) {
+			dates = append(dates, d)
+		}
+		d = next(d, p.Frequency)
+	}
+	return dates
+}
+
+// ExpandNote substitutes date placeholders in a recurring pattern's note
+// with values from the occurrence date at, so a template like "Rent for
+// {month} {year}" reads as "Rent for March 2026" for each occurrence
+// instead of repeating the same static text.
+func ExpandNote(note string, at time.Time) string {
+	r := strings.NewReplacer(
+		"{month}", at.Format("January"),
+		"{year}", at.Format("2006"),
+		"{weekday}", at.Format("Monday"),
+		"{date}", at.Format("Jan 2, 2006"),
+	)
+	return r.Replace(note)
+}
+
+func next(d time.Time, f model.Frequency) time.Time {
+	switch f {
+	case model.FrequencyDaily:
+		return d.AddDate(0, 0, 1)
+	case model.FrequencyWeekly:
+		return d.AddDate(0, 0, 7)
+	case model.FrequencyYearly:
+		return d.AddDate(1, 0, 0)
+	default:
+		return d.AddDate(0, 1, 0)
+	}
+}
@@ -0,0 +1,61 @@
+package recurring
+
+import (
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// MonthlyEquivalent normalizes p's amount to a monthly cost, so patterns on
+// different schedules can be summed meaningfully.
+func MonthlyEquivalent(p *model.RecurringPattern) money.Money {
+	switch p.Frequency {
+	case model.FrequencyDaily:
+		return money.FromFloat(p.Amount.Float64() * 30.44)
+	case model.FrequencyWeekly:
+		return money.FromFloat(p.Amount.Float64() * 4.345)
+	case model.FrequencyYearly:
+		return money.FromFloat(p.Amount.Float64() / 12)
+	default:
+		return p.Amount
+	}
+}
+
+// VendorSummary is the combined monthly cost of every active pattern
+// sharing a Vendor, e.g. two Amazon subscriptions billed separately.
+type VendorSummary struct {
+	Vendor       string                    `json:"vendor"`
+	MonthlyTotal money.Money               `json:"monthly_total"`
+	PatternCount int                       `json:"pattern_count"`
+	Patterns     []*model.RecurringPattern `json:"patterns"`
+}
+
+// ByVendor groups active patterns with a non-empty Vendor and sums their
+// monthly-equivalent cost, ordered by monthly total descending so the
+// biggest combined bills surface first. Patterns without a Vendor are
+// omitted, since there's nothing to group them by.
+func ByVendor(patterns []*model.RecurringPattern) []VendorSummary {
+	byVendor := make(map[string]*VendorSummary)
+	var order []string
+	for _, p := range patterns {
+		if !p.Active || p.Vendor == "" {
+			continue
+		}
+		v, ok := byVendor[p.Vendor]
+		if !ok {
+			v = &VendorSummary{Vendor: p.Vendor}
+			byVendor[p.Vendor] = v
+			order = append(order, p.Vendor)
+		}
+		v.MonthlyTotal += MonthlyEquivalent(p)
+		v.PatternCount++
+		v.Patterns = append(v.Patterns, p)
+	}
+	summaries := make([]VendorSummary, 0, len(order))
+	for _, name := range order {
+		summaries = append(summaries, *byVendor[name])
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].MonthlyTotal > summaries[j].MonthlyTotal })
+	return summaries
+}
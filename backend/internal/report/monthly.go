@@ -0,0 +1,50 @@
+// Package report assembles the data behind budgetapp's periodic reports.
+package report
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/stats"
+)
+
+// Monthly is the data behind a single month's report: totals, a category
+// breakdown for charting, and the period's largest expenses.
+type Monthly struct {
+	Month           string                `json:"month"`
+	Total           money.Money           `json:"total"`
+	CategoryTotals  []stats.MerchantTotal `json:"category_totals"`
+	LargestExpenses []*model.Expense      `json:"largest_expenses"`
+}
+
+// BuildMonthly summarizes expenses falling within the calendar month
+// containing month.
+func BuildMonthly(expenses []*model.Expense, month time.Time) Monthly {
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	to := from.AddDate(0, 1, 0)
+
+	totals := make(map[string]money.Money)
+	var total money.Money
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		totals[e.Category] += e.Amount
+		total += e.Amount
+	}
+
+	// MerchantTotal is a generic (label, total) pair; reused here for
+	// category totals rather than introducing an identical type.
+	var categoryTotals []stats.MerchantTotal
+	for c, t := range totals {
+		categoryTotals = append(categoryTotals, stats.MerchantTotal{Merchant: c, Total: t})
+	}
+
+	return Monthly{
+		Month:           from.Format("2006-01"),
+		Total:           total,
+		CategoryTotals:  categoryTotals,
+		LargestExpenses: stats.LargestExpenses(expenses, from, to, 10),
+	}
+}
@@ -0,0 +1,25 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestBuildMonthlyFiltersToMonth(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 10, Category: "food", Date: civildate.New(2026, time.January, 15)},
+		{Amount: 99, Category: "food", Date: civildate.New(2026, time.February, 1)},
+	}
+
+	m := BuildMonthly(expenses, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	if m.Month != "2026-01" {
+		t.Errorf("month = %s, want 2026-01", m.Month)
+	}
+	if m.Total != 10 {
+		t.Errorf("total = %v, want 10", m.Total)
+	}
+}
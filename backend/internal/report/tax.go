@@ -0,0 +1,54 @@
+package report
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/stats"
+)
+
+// Tax is the data behind a single calendar year's tax report: the
+// deductible spend broken down by category, and the tax paid on top of it,
+// for freelancers filing quarterly or annual estimates.
+type Tax struct {
+	Year                 int                   `json:"year"`
+	DeductibleTotal      money.Money           `json:"deductible_total"`
+	DeductibleByCategory []stats.MerchantTotal `json:"deductible_by_category"`
+	TaxPaid              money.Money           `json:"tax_paid"`
+}
+
+// BuildTax summarizes deductible expenses and tax paid falling within the
+// calendar year. Tax paid is summed across all expenses, not just
+// deductible ones, since sales tax/VAT is generally reportable regardless
+// of whether the underlying purchase is a deduction.
+func BuildTax(expenses []*model.Expense, year int) Tax {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	byCategory := make(map[string]money.Money)
+	var deductibleTotal, taxPaid money.Money
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		taxPaid += e.TaxAmount
+		if !e.Deductible {
+			continue
+		}
+		byCategory[e.Category] += e.Amount
+		deductibleTotal += e.Amount
+	}
+
+	var categoryTotals []stats.MerchantTotal
+	for c, t := range byCategory {
+		categoryTotals = append(categoryTotals, stats.MerchantTotal{Merchant: c, Total: t})
+	}
+
+	return Tax{
+		Year:                 year,
+		DeductibleTotal:      deductibleTotal,
+		DeductibleByCategory: categoryTotals,
+		TaxPaid:              taxPaid,
+	}
+}
@@ -0,0 +1,39 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestBuildTaxFiltersToYearAndDeductibleStatus(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 100, TaxAmount: 8, Category: "software", Deductible: true, Date: civildate.New(2026, time.March, 1)},
+		{Amount: 50, TaxAmount: 4, Category: "meals", Deductible: false, Date: civildate.New(2026, time.June, 1)},
+		{Amount: 200, TaxAmount: 16, Category: "software", Deductible: true, Date: civildate.New(2025, time.December, 1)},
+	}
+
+	got := BuildTax(expenses, 2026)
+
+	if got.Year != 2026 {
+		t.Errorf("year = %d, want 2026", got.Year)
+	}
+	if got.DeductibleTotal != 100 {
+		t.Errorf("deductible total = %v, want 100", got.DeductibleTotal)
+	}
+	if got.TaxPaid != 12 {
+		t.Errorf("tax paid = %v, want 12 (deductible and non-deductible tax both count)", got.TaxPaid)
+	}
+	if len(got.DeductibleByCategory) != 1 || got.DeductibleByCategory[0].Merchant != "software" {
+		t.Errorf("unexpected category breakdown: %+v", got.DeductibleByCategory)
+	}
+}
+
+func TestBuildTaxWithNoExpensesIsZero(t *testing.T) {
+	got := BuildTax(nil, 2026)
+	if got.DeductibleTotal != 0 || got.TaxPaid != 0 || len(got.DeductibleByCategory) != 0 {
+		t.Errorf("expected zero-value report, got %+v", got)
+	}
+}
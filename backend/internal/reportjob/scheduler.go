@@ -0,0 +1,81 @@
+// Package reportjob schedules generation of the monthly PDF/CSV report,
+// saving it to a reports directory and optionally emailing a summary, so
+// users get statements without asking for them.
+package reportjob
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/export"
+	"halclarke97/budgetapp/backend/internal/mailer"
+	"halclarke97/budgetapp/backend/internal/report"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// RunScheduler checks once per interval whether the previous calendar
+// month's report has been generated yet and, if not, builds and saves it.
+// It blocks until stop is closed, so callers should run it in its own
+// goroutine.
+func RunScheduler(st *store.Store, reportsDir string, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if err := checkAndGenerate(st, reportsDir, now); err != nil {
+				slog.Error("reportjob: generation failed", "error", err)
+			}
+		}
+	}
+}
+
+func checkAndGenerate(st *store.Store, reportsDir string, now time.Time) error {
+	s := st.Settings()
+	if !s.ReportEnabled {
+		return nil
+	}
+	loc := s.Location()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	if !monthStart.After(s.ReportLastGenerated) {
+		// The report for the month before monthStart was already generated.
+		return nil
+	}
+	prevMonth := monthStart.AddDate(0, -1, 0)
+
+	m := report.BuildMonthly(st.List(), prevMonth)
+	if err := save(reportsDir, m); err != nil {
+		return fmt.Errorf("save report: %w", err)
+	}
+	if s.ReportRecipient != "" {
+		subject := fmt.Sprintf("Monthly report: %s", m.Month)
+		body := fmt.Sprintf("Your %s report is ready: total spent %s.\n\nThe full PDF and CSV are saved in the reports directory and available via GET /api/reports.", m.Month, m.Total)
+		if err := mailer.Send(s.SMTP, s.ReportRecipient, subject, body); err != nil {
+			slog.Error("reportjob: email delivery failed", "recipient", s.ReportRecipient, "error", err)
+		}
+	}
+
+	s.ReportLastGenerated = monthStart
+	return st.UpdateSettings(context.Background(), s)
+}
+
+// save writes m's PDF and CSV renderings into dir, creating it if needed.
+func save(dir string, m report.Monthly) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "report-"+m.Month+".pdf"), export.MonthlyReportPDF(m), 0o644); err != nil {
+		return err
+	}
+	csvData, err := export.MonthlyReportCSV(m)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "report-"+m.Month+".csv"), csvData, 0o644)
+}
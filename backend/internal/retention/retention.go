@@ -0,0 +1,155 @@
+// Package retention enforces age-based cutoffs for the append-only history
+// logs (audit events, alert events, sweep logs) on top of the hard count
+// caps already enforced in internal/store, so a long-lived instance's data
+// file doesn't grow forever once those caps alone aren't enough. It also
+// purges expenses old enough per Settings.Retention.ExpenseDefaultDays and
+// any per-category or per-project RetentionDays override, for users who
+// want personal spending swept away sooner than business records they need
+// to keep for tax or legal reasons.
+package retention
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// Report summarizes one purge pass.
+type Report struct {
+	// DryRun reports whether nothing was actually removed, only counted.
+	DryRun            bool `json:"dry_run"`
+	AuditEventsPurged int  `json:"audit_events_purged"`
+	AlertEventsPurged int  `json:"alert_events_purged"`
+	SweepLogsPurged   int  `json:"sweep_logs_purged"`
+	ExpensesPurged    int  `json:"expenses_purged"`
+}
+
+// ExpenseRetentionDays returns the minimum number of days e must age before
+// it's eligible for purge: the largest of defaultDays and any RetentionDays
+// set on e's category or linked project, so a legal-hold requirement on
+// either one always wins over a shorter default rather than being
+// overridden by it. Zero means e is never eligible.
+func ExpenseRetentionDays(cat *model.Category, proj *model.Project, defaultDays int) int {
+	days := defaultDays
+	if cat != nil && cat.RetentionDays > days {
+		days = cat.RetentionDays
+	}
+	if proj != nil && proj.RetentionDays > days {
+		days = proj.RetentionDays
+	}
+	return days
+}
+
+// Sweep purges every log whose Settings.Retention day count is set and
+// elapsed, relative to now. A log with a zero day count is left alone: it's
+// still bounded by its count cap. With dryRun true, Sweep counts what would
+// be removed without persisting anything, so a retention change can be
+// previewed before it takes effect.
+func Sweep(ctx context.Context, st *store.Store, now time.Time, dryRun bool) (Report, error) {
+	sett := st.Settings()
+	report := Report{DryRun: dryRun}
+
+	if days := sett.Retention.AuditEventDays; days > 0 {
+		cutoff := now.AddDate(0, 0, -days)
+		if dryRun {
+			for _, e := range st.ListAuditEvents() {
+				if e.CreatedAt.Before(cutoff) {
+					report.AuditEventsPurged++
+				}
+			}
+		} else {
+			n, err := st.PurgeAuditEventsBefore(ctx, cutoff)
+			if err != nil {
+				return report, err
+			}
+			report.AuditEventsPurged = n
+		}
+	}
+
+	if days := sett.Retention.AlertEventDays; days > 0 {
+		cutoff := now.AddDate(0, 0, -days)
+		if dryRun {
+			for _, e := range st.ListAlertEvents() {
+				if e.TriggeredAt.Before(cutoff) {
+					report.AlertEventsPurged++
+				}
+			}
+		} else {
+			n, err := st.PurgeAlertEventsBefore(ctx, cutoff)
+			if err != nil {
+				return report, err
+			}
+			report.AlertEventsPurged = n
+		}
+	}
+
+	if days := sett.Retention.SweepLogDays; days > 0 {
+		cutoff := now.AddDate(0, 0, -days)
+		if dryRun {
+			for _, l := range st.ListSweepLogs() {
+				if l.StartedAt.Before(cutoff) {
+					report.SweepLogsPurged++
+				}
+			}
+		} else {
+			n, err := st.PurgeSweepLogsBefore(ctx, cutoff)
+			if err != nil {
+				return report, err
+			}
+			report.SweepLogsPurged = n
+		}
+	}
+
+	categories := make(map[string]*model.Category)
+	for _, c := range st.Categories() {
+		categories[c.Name] = c
+	}
+	projects := make(map[string]*model.Project)
+	for _, p := range st.ListProjects(true) {
+		projects[p.ID] = p
+	}
+	var expireIDs []string
+	for _, e := range st.List() {
+		days := ExpenseRetentionDays(categories[e.Category], projects[e.ProjectID], sett.Retention.ExpenseDefaultDays)
+		if days <= 0 {
+			continue
+		}
+		if e.Date.Time().Before(now.AddDate(0, 0, -days)) {
+			expireIDs = append(expireIDs, e.ID)
+		}
+	}
+	if len(expireIDs) > 0 {
+		if dryRun {
+			report.ExpensesPurged = len(expireIDs)
+		} else {
+			n, err := st.PurgeExpenses(ctx, expireIDs)
+			if err != nil {
+				return report, err
+			}
+			report.ExpensesPurged = n
+		}
+	}
+
+	return report, nil
+}
+
+// RunScheduler runs Sweep once per interval, purging for real each time. It
+// blocks until stop is closed, so callers should run it in their own
+// goroutine.
+func RunScheduler(st *store.Store, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if _, err := Sweep(context.Background(), st, now, false); err != nil {
+				slog.Error("retention: purge failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Package settings holds user-configurable preferences that affect how
+// stats periods are computed.
+package settings
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Settings are the user's preferences for how calendar periods are divided.
+type Settings struct {
+	// WeekStart is the weekday a "week" period begins on.
+	WeekStart time.Weekday `json:"week_start"`
+	// FiscalMonthStartDay is the day of the month a "month" period begins
+	// on, for users whose budgeting cycle doesn't follow the calendar
+	// month (e.g. payday on the 25th).
+	FiscalMonthStartDay int `json:"fiscal_month_start_day"`
+	// Timezone is an IANA time zone name (e.g. "America/Los_Angeles") used
+	// to compute day/week/month boundaries. Empty means UTC.
+	Timezone string `json:"timezone"`
+	// ICSSecret signs subscribable calendar feed URLs (e.g. upcoming
+	// bills). Generated once on first use; never returned by the API.
+	ICSSecret string `json:"ics_secret,omitempty"`
+	// Currency is the ISO 4217 code amounts are recorded in, e.g. "USD".
+	// It's informational only: money.Money always stores minor units
+	// assuming two decimal places, regardless of this setting.
+	Currency string `json:"currency"`
+	// Locale is a BCP 47 language tag (e.g. "en-US") the frontend should
+	// use to format numbers and dates consistently with Currency.
+	Locale string `json:"locale"`
+	// RoundingMode selects the tie-breaking rule used wherever a fractional
+	// amount must be rounded to a whole cent (CPI inflation adjustment,
+	// stats averages). Empty means money.RoundHalfUp.
+	RoundingMode money.RoundingMode `json:"rounding_mode,omitempty"`
+
+	// SMTP holds the outgoing mail server used to send digest emails. Zero
+	// value means digests are not configured.
+	SMTP SMTPConfig `json:"smtp"`
+	// DigestEnabled turns on the scheduled digest email.
+	DigestEnabled bool `json:"digest_enabled"`
+	// DigestFrequency is how often the digest is sent: "week" or "month".
+	DigestFrequency string `json:"digest_frequency"`
+	// DigestRecipient is the address the digest is mailed to.
+	DigestRecipient string `json:"digest_recipient"`
+	// DigestLastSent is the start of the last period a digest was sent for,
+	// used to avoid sending the same period's digest twice.
+	DigestLastSent time.Time `json:"digest_last_sent,omitempty"`
+
+	// ReportEnabled turns on scheduled monthly report generation.
+	ReportEnabled bool `json:"report_enabled"`
+	// ReportRecipient, if set, is emailed a summary of each generated
+	// report in addition to it being saved to the reports directory.
+	ReportRecipient string `json:"report_recipient,omitempty"`
+	// ReportLastGenerated is the start of the last calendar month a report
+	// was generated for, used to avoid regenerating the same month twice.
+	ReportLastGenerated time.Time `json:"report_last_generated,omitempty"`
+
+	// OverallMonthlyBudget is the household's total spending target for the
+	// month, used to compute safe-to-spend. Zero means no overall budget is
+	// configured.
+	OverallMonthlyBudget money.Money `json:"overall_monthly_budget"`
+
+	// LargeExpenseThreshold triggers a notification when a newly recorded
+	// expense's amount is at or above it. Zero disables the check.
+	LargeExpenseThreshold money.Money `json:"large_expense_threshold"`
+
+	// QuietHoursStart and QuietHoursEnd bound the hours (0-23, in Timezone)
+	// during which notifications are queued instead of delivered
+	// immediately, so alert channels don't ping overnight when the nightly
+	// sweep backfills bills. Equal values (including the zero value)
+	// disable quiet hours.
+	QuietHoursStart int `json:"quiet_hours_start"`
+	QuietHoursEnd   int `json:"quiet_hours_end"`
+	// NotificationBatching, if set, queues every notification instead of
+	// delivering it immediately, regardless of quiet hours; RunQueueFlush
+	// later delivers them all as one combined daily summary.
+	NotificationBatching bool `json:"notification_batching"`
+	// QueueLastFlushed is the start of the last day queued notifications
+	// were flushed for, used to avoid flushing the same day twice.
+	QueueLastFlushed time.Time `json:"queue_last_flushed,omitempty"`
+
+	// PlaidClientID and PlaidSecret authenticate against the bank
+	// aggregation API. Empty PlaidClientID disables scheduled bank sync.
+	PlaidClientID string `json:"plaid_client_id,omitempty"`
+	// PlaidSecret is never returned by the API.
+	PlaidSecret string `json:"plaid_secret,omitempty"`
+	// PlaidBaseURL overrides the aggregator's API base URL, for pointing at
+	// a sandbox environment. Empty means the production endpoint.
+	PlaidBaseURL string `json:"plaid_base_url,omitempty"`
+
+	// GoogleSheets holds the OAuth client and spreadsheet used to mirror
+	// expenses into a shared Google Sheet. Empty ClientID disables the sync.
+	GoogleSheets GoogleSheetsConfig `json:"google_sheets"`
+
+	// CPIIndex is a year (as a string, since JSON object keys must be
+	// strings) to consumer price index value table, used to inflation-
+	// adjust multi-year stats into constant currency. An empty table
+	// disables adjustment.
+	CPIIndex map[string]float64 `json:"cpi_index,omitempty"`
+
+	// Retention configures the scheduled purge job's age-based cutoffs for
+	// append-only history logs, on top of the hard count caps already
+	// enforced in internal/store (maxAuditEvents, maxAlertEvents,
+	// maxSweepLogs).
+	Retention RetentionConfig `json:"retention"`
+}
+
+// RetentionConfig sets how many days of history each log keeps before the
+// scheduled purge job removes the rest. Zero disables age-based purging for
+// that log; it's still bounded by its count cap.
+type RetentionConfig struct {
+	AuditEventDays int `json:"audit_event_days"`
+	AlertEventDays int `json:"alert_event_days"`
+	SweepLogDays   int `json:"sweep_log_days"`
+	// ExpenseDefaultDays, if set, is the minimum age an expense must reach
+	// before it's eligible for purge when its category and any linked
+	// project don't say otherwise. A category's or project's own
+	// RetentionDays always wins if it asks for a longer minimum, so this
+	// is a floor for personal spending, not a ceiling on business records;
+	// see internal/retention.ExpenseRetentionDays. Zero (with every
+	// category and project also at zero) leaves expenses out of the purge
+	// entirely, matching the other Retention fields' "0 disables" rule.
+	ExpenseDefaultDays int `json:"expense_default_days"`
+}
+
+// GoogleSheetsConfig authenticates against the Sheets API on behalf of a
+// user who authorized budgetapp out-of-band and pasted back a refresh token.
+type GoogleSheetsConfig struct {
+	ClientID     string `json:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+	// RefreshToken is never returned by the API.
+	RefreshToken  string `json:"refresh_token,omitempty"`
+	SpreadsheetID string `json:"spreadsheet_id,omitempty"`
+	SheetName     string `json:"sheet_name,omitempty"`
+	// SyncMode is "append" (push each new expense as it's created) or
+	// "full" (periodically overwrite the sheet with the full expense list).
+	SyncMode string `json:"sync_mode,omitempty"`
+}
+
+// SMTPConfig holds the credentials for sending outgoing mail.
+type SMTPConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	// Password is never returned by the API.
+	Password string `json:"password,omitempty"`
+	From     string `json:"from"`
+}
+
+// Default returns the settings used when none have been configured:
+// calendar weeks starting Monday, calendar months starting on the 1st, UTC.
+func Default() Settings {
+	return Settings{WeekStart: time.Monday, FiscalMonthStartDay: 1, Timezone: "UTC", Currency: "USD", Locale: "en-US"}
+}
+
+// Location resolves Timezone to a *time.Location, falling back to UTC if
+// unset or unrecognized.
+func (s Settings) Location() *time.Location {
+	if s.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,129 @@
+// Package simulate projects the monthly and annual impact of hypothetical
+// spending changes (cancel a subscription, add a new cost, cut a category)
+// against a user's existing spending averages, for a what-if budgeting
+// tool. It doesn't touch the store: every change is purely hypothetical.
+package simulate
+
+import (
+	"fmt"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// lookbackMonths is how far back averages are computed over. Six months
+// smooths out one-off spikes without going so far back that a merchant's
+// current spending pattern is diluted by an old one.
+const lookbackMonths = 6
+
+// ChangeType identifies which kind of hypothetical change a Change
+// describes.
+type ChangeType string
+
+const (
+	// ChangeCancel removes a merchant's average monthly spend entirely,
+	// e.g. cancelling a subscription.
+	ChangeCancel ChangeType = "cancel"
+	// ChangeAdd introduces a new fixed monthly cost.
+	ChangeAdd ChangeType = "add"
+	// ChangeCut reduces a category's average monthly spend by a percentage.
+	ChangeCut ChangeType = "cut"
+)
+
+// Change is a single hypothetical adjustment to simulate.
+type Change struct {
+	Type ChangeType `json:"type"`
+	// Merchant identifies what to cancel, for ChangeCancel.
+	Merchant string `json:"merchant,omitempty"`
+	// Category identifies what to cut, for ChangeCut.
+	Category string `json:"category,omitempty"`
+	// Amount is the new monthly cost, for ChangeAdd.
+	Amount money.Money `json:"amount,omitempty"`
+	// PercentCut is the percentage (e.g. 20 for 20%) to reduce Category's
+	// average by, for ChangeCut.
+	PercentCut float64 `json:"percent_cut,omitempty"`
+}
+
+// Impact is the projected monthly effect of a single Change. Delta is
+// negative for a saving and positive for an added cost.
+type Impact struct {
+	Change Change      `json:"change"`
+	Delta  money.Money `json:"monthly_delta"`
+}
+
+// Result is the combined projection across every simulated Change.
+type Result struct {
+	Impacts          []Impact    `json:"impacts"`
+	CurrentMonthly   money.Money `json:"current_monthly"`
+	ProjectedMonthly money.Money `json:"projected_monthly"`
+	MonthlyDelta     money.Money `json:"monthly_delta"`
+	AnnualDelta      money.Money `json:"annual_delta"`
+}
+
+// Run projects the impact of changes against expenses' trailing
+// lookbackMonths average, as of now.
+func Run(expenses []*model.Expense, changes []Change, now time.Time) (Result, error) {
+	from := now.AddDate(0, -lookbackMonths, 0)
+	current := averageMonthly(expenses, from, now, func(*model.Expense) bool { return true })
+
+	impacts := make([]Impact, 0, len(changes))
+	var delta money.Money
+	for _, c := range changes {
+		d, err := impactOf(expenses, c, from, now)
+		if err != nil {
+			return Result{}, err
+		}
+		impacts = append(impacts, Impact{Change: c, Delta: d})
+		delta += d
+	}
+
+	return Result{
+		Impacts:          impacts,
+		CurrentMonthly:   current,
+		ProjectedMonthly: current + delta,
+		MonthlyDelta:     delta,
+		AnnualDelta:      delta * 12,
+	}, nil
+}
+
+func impactOf(expenses []*model.Expense, c Change, from, now time.Time) (money.Money, error) {
+	switch c.Type {
+	case ChangeCancel:
+		if c.Merchant == "" {
+			return 0, fmt.Errorf("cancel requires a merchant")
+		}
+		avg := averageMonthly(expenses, from, now, func(e *model.Expense) bool { return e.Merchant == c.Merchant })
+		return -avg, nil
+	case ChangeAdd:
+		if c.Amount <= 0 {
+			return 0, fmt.Errorf("add requires a positive amount")
+		}
+		return c.Amount, nil
+	case ChangeCut:
+		if c.Category == "" {
+			return 0, fmt.Errorf("cut requires a category")
+		}
+		if c.PercentCut <= 0 || c.PercentCut > 100 {
+			return 0, fmt.Errorf("percent_cut must be between 0 and 100")
+		}
+		avg := averageMonthly(expenses, from, now, func(e *model.Expense) bool { return e.Category == c.Category })
+		return -money.FromFloat(avg.Float64() * c.PercentCut / 100), nil
+	default:
+		return 0, fmt.Errorf("unknown change type %q", c.Type)
+	}
+}
+
+// averageMonthly sums expenses in [from, now) matching keep and divides by
+// lookbackMonths, so a merchant only charged in some months still gets a
+// realistic average rather than being measured against the months it
+// wasn't active.
+func averageMonthly(expenses []*model.Expense, from, now time.Time, keep func(*model.Expense) bool) money.Money {
+	var total money.Money
+	for _, e := range expenses {
+		if !e.Date.Time().Before(from) && e.Date.Time().Before(now) && keep(e) {
+			total += e.Amount
+		}
+	}
+	return total / lookbackMonths
+}
@@ -0,0 +1,65 @@
+package stats
+
+import (
+	"math"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// Anomaly is an expense flagged as a statistical outlier within its category.
+type Anomaly struct {
+	Expense        *model.Expense `json:"expense"`
+	CategoryMean   float64        `json:"category_mean"`
+	CategoryStdDev float64        `json:"category_std_dev"`
+	Deviations     float64        `json:"deviations"`
+}
+
+// Anomalies flags expenses more than threshold standard deviations above
+// their category's mean. Categories with fewer than 2 expenses are skipped,
+// since a standard deviation is meaningless with so little data.
+func Anomalies(expenses []*model.Expense, threshold float64) []Anomaly {
+	byCategory := make(map[string][]*model.Expense)
+	for _, e := range expenses {
+		byCategory[e.Category] = append(byCategory[e.Category], e)
+	}
+
+	var anomalies []Anomaly
+	for _, group := range byCategory {
+		if len(group) < 2 {
+			continue
+		}
+		mean, stddev := meanAndStdDev(group)
+		if stddev == 0 {
+			continue
+		}
+		for _, e := range group {
+			deviations := (e.Amount.Float64() - mean) / stddev
+			if deviations > threshold {
+				anomalies = append(anomalies, Anomaly{
+					Expense:        e,
+					CategoryMean:   mean,
+					CategoryStdDev: stddev,
+					Deviations:     deviations,
+				})
+			}
+		}
+	}
+	return anomalies
+}
+
+func meanAndStdDev(expenses []*model.Expense) (mean, stddev float64) {
+	var sum money.Money
+	for _, e := range expenses {
+		sum += e.Amount
+	}
+	mean = sum.Float64() / float64(len(expenses))
+
+	var variance float64
+	for _, e := range expenses {
+		d := e.Amount.Float64() - mean
+		variance += d * d
+	}
+	variance /= float64(len(expenses))
+	return mean, math.Sqrt(variance)
+}
@@ -0,0 +1,69 @@
+package stats
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// RollingPoint is a smoothed spending value for a single day.
+type RollingPoint struct {
+	Date  string  `json:"date"`
+	Value float64 `json:"value"`
+}
+
+// Burn summarizes spending velocity: smoothed rolling averages plus the
+// current daily burn rate.
+type Burn struct {
+	Rolling7Day  []RollingPoint `json:"rolling_7_day"`
+	Rolling30Day []RollingPoint `json:"rolling_30_day"`
+	DailyRate    float64        `json:"daily_rate"`
+}
+
+// dailyTotals buckets expenses in [from, to) into one total per calendar day.
+func dailyTotals(expenses []*model.Expense, from, to time.Time) map[string]money.Money {
+	totals := make(map[string]money.Money)
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		totals[e.Date.String()] += e.Amount
+	}
+	return totals
+}
+
+// rollingAverage computes a trailing window-day average ending at each day
+// in [from, to).
+func rollingAverage(totals map[string]money.Money, from, to time.Time, window int) []RollingPoint {
+	var points []RollingPoint
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		var sum money.Money
+		for i := 0; i < window; i++ {
+			sum += totals[d.AddDate(0, 0, -i).Format("2006-01-02")]
+		}
+		points = append(points, RollingPoint{
+			Date:  d.Format("2006-01-02"),
+			Value: sum.Float64() / float64(window),
+		})
+	}
+	return points
+}
+
+// BurnRate computes rolling 7-day and 30-day averages over [from, to), plus
+// the current daily burn rate (the trailing 30-day average as of to).
+func BurnRate(expenses []*model.Expense, from, to time.Time) Burn {
+	// Pull totals from 30 days before "from" so early rolling windows are
+	// still fully populated.
+	windowStart := from.AddDate(0, 0, -30)
+	totals := dailyTotals(expenses, windowStart, to)
+
+	b := Burn{
+		Rolling7Day:  rollingAverage(totals, from, to, 7),
+		Rolling30Day: rollingAverage(totals, from, to, 30),
+	}
+	if len(b.Rolling30Day) > 0 {
+		b.DailyRate = b.Rolling30Day[len(b.Rolling30Day)-1].Value
+	}
+	return b
+}
@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// CashflowPoint is income, expenses, and the derived net/savings rate for a
+// single bucket in a cash flow series.
+type CashflowPoint struct {
+	Bucket      string      `json:"bucket"`
+	Income      money.Money `json:"income"`
+	Expenses    money.Money `json:"expenses"`
+	Net         money.Money `json:"net"`
+	SavingsRate float64     `json:"savings_rate"`
+}
+
+// Cashflow buckets income and expenses in [from, to) by granularity,
+// honoring the user's week-start/fiscal-month-start settings, reporting net
+// cash flow and savings rate (net / income, zero when income is zero) per
+// bucket.
+func Cashflow(income []*model.Income, expenses []*model.Expense, from, to time.Time, g Granularity, s settings.Settings) []CashflowPoint {
+	incomeTotals := make(map[string]money.Money)
+	for _, inc := range income {
+		if inc.Date.Before(from) || !inc.Date.Before(to) {
+			continue
+		}
+		incomeTotals[bucketKey(period.Start(inc.Date, g, s), g)] += inc.Amount
+	}
+	expenseTotals := make(map[string]money.Money)
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		expenseTotals[bucketKey(period.Start(e.Date.Time(), g, s), g)] += e.Amount
+	}
+
+	var points []CashflowPoint
+	for b := period.Start(from, g, s); b.Before(to); b = period.Next(b, g) {
+		key := bucketKey(b, g)
+		inc := incomeTotals[key]
+		exp := expenseTotals[key]
+		net := inc - exp
+		var rate float64
+		if inc > 0 {
+			rate = net.Float64() / inc.Float64()
+		}
+		points = append(points, CashflowPoint{Bucket: key, Income: inc, Expenses: exp, Net: net, SavingsRate: rate})
+	}
+	return points
+}
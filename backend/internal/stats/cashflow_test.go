@@ -0,0 +1,35 @@
+package stats
+
+import (
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+func TestCashflowComputesNetAndSavingsRate(t *testing.T) {
+	income := []*model.Income{
+		{Amount: 4000, Date: mustDate("2026-01-05")},
+	}
+	expenses := []*model.Expense{
+		{Amount: 3000, Date: mustCivilDate("2026-01-10")},
+	}
+	from, to := mustDate("2026-01-01"), mustDate("2026-03-01")
+
+	points := Cashflow(income, expenses, from, to, GranularityMonth, settings.Default())
+
+	if len(points) != 2 {
+		t.Fatalf("expected 2 monthly buckets, got %d", len(points))
+	}
+	jan := points[0]
+	if jan.Income != 4000 || jan.Expenses != 3000 || jan.Net != 1000 {
+		t.Errorf("unexpected january bucket: %+v", jan)
+	}
+	if jan.SavingsRate != 0.25 {
+		t.Errorf("SavingsRate = %v, want 0.25", jan.SavingsRate)
+	}
+	feb := points[1]
+	if feb.Income != 0 || feb.Expenses != 0 || feb.SavingsRate != 0 {
+		t.Errorf("expected zeroed february bucket, got %+v", feb)
+	}
+}
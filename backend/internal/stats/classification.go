@@ -0,0 +1,39 @@
+package stats
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// ClassificationSummary splits spend in [from, to) into business and
+// personal totals, with a category breakdown of the business side for
+// expense reports.
+type ClassificationSummary struct {
+	BusinessTotal      money.Money     `json:"business_total"`
+	PersonalTotal      money.Money     `json:"personal_total"`
+	BusinessByCategory []MerchantTotal `json:"business_by_category"`
+}
+
+// ByClassification computes a ClassificationSummary for expenses falling
+// within [from, to).
+func ByClassification(expenses []*model.Expense, from, to time.Time) ClassificationSummary {
+	byCategory := make(map[string]money.Money)
+	var summary ClassificationSummary
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		if classificationOrPersonal(e) == model.ClassificationBusiness {
+			summary.BusinessTotal += e.Amount
+			byCategory[e.Category] += e.Amount
+		} else {
+			summary.PersonalTotal += e.Amount
+		}
+	}
+	for c, t := range byCategory {
+		summary.BusinessByCategory = append(summary.BusinessByCategory, MerchantTotal{Merchant: c, Total: t})
+	}
+	return summary
+}
@@ -0,0 +1,28 @@
+package stats
+
+import (
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestByClassificationSplitsBusinessAndPersonal(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 100, Category: "software", Classification: model.ClassificationBusiness, Date: mustCivilDate("2026-01-05")},
+		{Amount: 40, Category: "groceries", Date: mustCivilDate("2026-01-10")},
+		{Amount: 60, Category: "software", Classification: model.ClassificationBusiness, Date: mustCivilDate("2026-02-01")},
+	}
+	from, to := mustDate("2026-01-01"), mustDate("2026-02-01")
+
+	got := ByClassification(expenses, from, to)
+
+	if got.BusinessTotal != 100 {
+		t.Errorf("business total = %v, want 100", got.BusinessTotal)
+	}
+	if got.PersonalTotal != 40 {
+		t.Errorf("personal total = %v, want 40 (unset classification defaults to personal)", got.PersonalTotal)
+	}
+	if len(got.BusinessByCategory) != 1 || got.BusinessByCategory[0].Merchant != "software" {
+		t.Errorf("unexpected business category breakdown: %+v", got.BusinessByCategory)
+	}
+}
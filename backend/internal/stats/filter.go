@@ -0,0 +1,34 @@
+package stats
+
+import "halclarke97/budgetapp/backend/internal/model"
+
+// FilterByCategory returns the subset of expenses in the given category.
+func FilterByCategory(expenses []*model.Expense, category string) []*model.Expense {
+	filtered := make([]*model.Expense, 0, len(expenses))
+	for _, e := range expenses {
+		if e.Category == category {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// FilterByClassification returns the subset of expenses with the given
+// classification. Expenses with no classification set are treated as
+// model.ClassificationPersonal.
+func FilterByClassification(expenses []*model.Expense, c model.Classification) []*model.Expense {
+	filtered := make([]*model.Expense, 0, len(expenses))
+	for _, e := range expenses {
+		if classificationOrPersonal(e) == c {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+func classificationOrPersonal(e *model.Expense) model.Classification {
+	if e.Classification == "" {
+		return model.ClassificationPersonal
+	}
+	return e.Classification
+}
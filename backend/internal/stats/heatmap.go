@@ -0,0 +1,100 @@
+package stats
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// DayTotal is the spending total for a single calendar day.
+type DayTotal struct {
+	Date  string      `json:"date"`
+	Total money.Money `json:"total"`
+}
+
+// Heatmap is a full year of daily spending totals, GitHub-contribution style,
+// plus weekday/weekend averages for quick comparison.
+type Heatmap struct {
+	Year           int        `json:"year"`
+	Days           []DayTotal `json:"days"`
+	WeekdayAverage float64    `json:"weekday_average"`
+	WeekendAverage float64    `json:"weekend_average"`
+}
+
+// BuildHeatmap computes per-day totals for every day of year. loc is
+// accepted for signature compatibility with callers that also bucket by
+// timezone, but Date has no timezone of its own (see internal/civildate):
+// its calendar day is used as-is.
+func BuildHeatmap(expenses []*model.Expense, year int, loc *time.Location) Heatmap {
+	totals := make(map[string]money.Money)
+	for _, e := range expenses {
+		if e.Date.Time().Year() != year {
+			continue
+		}
+		totals[e.Date.String()] += e.Amount
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	var days []DayTotal
+	var weekdaySum, weekendSum money.Money
+	var weekdayCount, weekendCount int
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		total := totals[key]
+		days = append(days, DayTotal{Date: key, Total: total})
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			weekendSum += total
+			weekendCount++
+		} else {
+			weekdaySum += total
+			weekdayCount++
+		}
+	}
+
+	h := Heatmap{Year: year, Days: days}
+	if weekdayCount > 0 {
+		h.WeekdayAverage = weekdaySum.Float64() / float64(weekdayCount)
+	}
+	if weekendCount > 0 {
+		h.WeekendAverage = weekendSum.Float64() / float64(weekendCount)
+	}
+	return h
+}
+
+// BuildHeatmapFromTotals is like BuildHeatmap but reads from a precomputed
+// UTC-day total map (as maintained incrementally by the store) instead of
+// rescanning every expense. Only correct when the caller's boundaries are
+// UTC; callers using a non-UTC timezone setting should use BuildHeatmap
+// against the raw expense list instead.
+func BuildHeatmapFromTotals(dailyTotals map[string]money.Money, year int) Heatmap {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var days []DayTotal
+	var weekdaySum, weekendSum money.Money
+	var weekdayCount, weekendCount int
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		total := dailyTotals[key]
+		days = append(days, DayTotal{Date: key, Total: total})
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			weekendSum += total
+			weekendCount++
+		} else {
+			weekdaySum += total
+			weekdayCount++
+		}
+	}
+
+	h := Heatmap{Year: year, Days: days}
+	if weekdayCount > 0 {
+		h.WeekdayAverage = weekdaySum.Float64() / float64(weekdayCount)
+	}
+	if weekendCount > 0 {
+		h.WeekendAverage = weekendSum.Float64() / float64(weekendCount)
+	}
+	return h
+}
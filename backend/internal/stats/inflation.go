@@ -0,0 +1,26 @@
+package stats
+
+import (
+	"strconv"
+
+	"halclarke97/budgetapp/backend/internal/cpi"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// AdjustForInflation rewrites each point's Total from the calendar year its
+// bucket falls in into constant toYear currency using src, rounding with
+// mode. A point whose bucket's year has no CPI entry in src is left
+// unadjusted rather than dropped, so a partial CPI table degrades
+// gracefully instead of hiding data.
+func AdjustForInflation(points []TrendPoint, toYear int, src cpi.Source, mode money.RoundingMode) []TrendPoint {
+	adjusted := make([]TrendPoint, len(points))
+	for i, p := range points {
+		year, err := strconv.Atoi(p.Bucket[:4])
+		if err != nil {
+			adjusted[i] = p
+			continue
+		}
+		adjusted[i] = TrendPoint{Bucket: p.Bucket, Total: cpi.Adjust(p.Total, year, toYear, src, mode)}
+	}
+	return adjusted
+}
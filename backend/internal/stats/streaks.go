@@ -0,0 +1,84 @@
+package stats
+
+import (
+	"strings"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// NoSpendDay reports whether a single calendar day had any qualifying
+// spending.
+type NoSpendDay struct {
+	Date  string `json:"date"`
+	Spent bool   `json:"spent"`
+}
+
+// Streaks summarizes no-spend days over a period: the day-by-day
+// breakdown, the total count, and the current and longest consecutive
+// runs.
+type Streaks struct {
+	Days          []NoSpendDay `json:"days"`
+	NoSpendCount  int          `json:"no_spend_count"`
+	CurrentStreak int          `json:"current_streak"`
+	LongestStreak int          `json:"longest_streak"`
+}
+
+// NoSpendStreaks computes no-spend days and streaks over [from, to). When
+// billMerchants is non-nil, expenses from those merchants (typically
+// active recurring bills) are ignored, so a day with only a rent or
+// subscription charge still counts as "no discretionary spend".
+func NoSpendStreaks(expenses []*model.Expense, from, to time.Time, billMerchants map[string]bool) Streaks {
+	spentDays := make(map[string]bool)
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		if billMerchants[strings.ToLower(e.Merchant)] {
+			continue
+		}
+		spentDays[e.Date.String()] = true
+	}
+
+	var days []NoSpendDay
+	longest, running := 0, 0
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		spent := spentDays[key]
+		days = append(days, NoSpendDay{Date: key, Spent: spent})
+		if spent {
+			running = 0
+		} else {
+			running++
+			if running > longest {
+				longest = running
+			}
+		}
+	}
+
+	current := 0
+	for i := len(days) - 1; i >= 0 && !days[i].Spent; i-- {
+		current++
+	}
+
+	count := 0
+	for _, d := range days {
+		if !d.Spent {
+			count++
+		}
+	}
+
+	return Streaks{Days: days, NoSpendCount: count, CurrentStreak: current, LongestStreak: longest}
+}
+
+// BillMerchants returns the lowercased set of merchants billed by active
+// recurring patterns, for use as NoSpendStreaks' exclusion set.
+func BillMerchants(patterns []*model.RecurringPattern) map[string]bool {
+	merchants := make(map[string]bool)
+	for _, p := range patterns {
+		if p.Active && p.Merchant != "" {
+			merchants[strings.ToLower(p.Merchant)] = true
+		}
+	}
+	return merchants
+}
@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// MerchantTotal is the total spend at a single merchant.
+type MerchantTotal struct {
+	Merchant string      `json:"merchant"`
+	Total    money.Money `json:"total"`
+}
+
+// TopMerchants returns the n merchants with the highest total spend in
+// [from, to), sorted descending. Expenses with no merchant are ignored.
+func TopMerchants(expenses []*model.Expense, from, to time.Time, n int) []MerchantTotal {
+	totals := make(map[string]money.Money)
+	for _, e := range expenses {
+		if e.Merchant == "" || e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		totals[e.Merchant] += e.Amount
+	}
+
+	list := make([]MerchantTotal, 0, len(totals))
+	for m, total := range totals {
+		list = append(list, MerchantTotal{Merchant: m, Total: total})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Total > list[j].Total })
+	if n > 0 && len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
+
+// FrequentCombo is an amount/category/merchant combination that recurs
+// across several expenses, e.g. the same $4.50 coffee logged repeatedly.
+type FrequentCombo struct {
+	Amount   money.Money `json:"amount"`
+	Category string      `json:"category"`
+	Merchant string      `json:"merchant"`
+	Count    int         `json:"count"`
+	LastUsed time.Time   `json:"last_used"`
+}
+
+// FrequentCombos returns the n amount/category/merchant combinations that
+// occur most often among expenses dated in [from, to), sorted by count
+// descending and, for ties, by most recently used. It powers one-tap
+// re-entry of expenses a user logs the same way over and over (a recurring
+// coffee, a regular parking fee) without the overhead of a full
+// RecurringPattern.
+func FrequentCombos(expenses []*model.Expense, from, to time.Time, n int) []FrequentCombo {
+	type key struct {
+		amount   money.Money
+		category string
+		merchant string
+	}
+	combos := make(map[key]*FrequentCombo)
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		k := key{amount: e.Amount, category: e.Category, merchant: e.Merchant}
+		c, ok := combos[k]
+		if !ok {
+			c = &FrequentCombo{Amount: e.Amount, Category: e.Category, Merchant: e.Merchant}
+			combos[k] = c
+		}
+		c.Count++
+		if used := e.Date.Time(); used.After(c.LastUsed) {
+			c.LastUsed = used
+		}
+	}
+
+	list := make([]FrequentCombo, 0, len(combos))
+	for _, c := range combos {
+		if c.Count < 2 {
+			continue
+		}
+		list = append(list, *c)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return list[i].LastUsed.After(list[j].LastUsed)
+	})
+	if n > 0 && len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
+
+// LargestExpenses returns the n largest individual expenses in [from, to),
+// sorted descending by amount.
+func LargestExpenses(expenses []*model.Expense, from, to time.Time, n int) []*model.Expense {
+	var list []*model.Expense
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Amount > list[j].Amount })
+	if n > 0 && len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
@@ -0,0 +1,54 @@
+// Package stats computes spending aggregates over a set of expenses.
+package stats
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/period"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// Granularity controls how a trend series is bucketed.
+type Granularity = period.Granularity
+
+const (
+	GranularityDay   = period.Day
+	GranularityWeek  = period.Week
+	GranularityMonth = period.Month
+)
+
+// TrendPoint is the spending total for a single bucket in a trend series.
+type TrendPoint struct {
+	Bucket string      `json:"bucket"`
+	Total  money.Money `json:"total"`
+}
+
+func bucketKey(t time.Time, g Granularity) string {
+	if g == GranularityMonth {
+		return t.Format("2006-01")
+	}
+	return t.Format("2006-01-02")
+}
+
+// Trend buckets expenses in [from, to) by granularity, honoring the user's
+// week-start/fiscal-month-start settings, and fills any bucket with no
+// spending with a zero total so the resulting series has no gaps.
+func Trend(expenses []*model.Expense, from, to time.Time, g Granularity, s settings.Settings) []TrendPoint {
+	totals := make(map[string]money.Money)
+	for _, e := range expenses {
+		if e.Date.Time().Before(from) || !e.Date.Time().Before(to) {
+			continue
+		}
+		key := bucketKey(period.Start(e.Date.Time(), g, s), g)
+		totals[key] += e.Amount
+	}
+
+	var points []TrendPoint
+	for b := period.Start(from, g, s); b.Before(to); b = period.Next(b, g) {
+		key := bucketKey(b, g)
+		points = append(points, TrendPoint{Bucket: key, Total: totals[key]})
+	}
+	return points
+}
@@ -0,0 +1,71 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+func mustDate(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func mustCivilDate(s string) civildate.Date {
+	return civildate.FromTime(mustDate(s))
+}
+
+func TestTrendFillsGaps(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 10, Date: mustCivilDate("2026-01-01")},
+		{Amount: 5, Date: mustCivilDate("2026-01-03")},
+	}
+	from, to := mustDate("2026-01-01"), mustDate("2026-01-05")
+
+	points := Trend(expenses, from, to, GranularityDay, settings.Default())
+
+	if len(points) != 4 {
+		t.Fatalf("expected 4 daily buckets, got %d", len(points))
+	}
+	want := map[string]money.Money{
+		"2026-01-01": 10,
+		"2026-01-02": 0,
+		"2026-01-03": 5,
+		"2026-01-04": 0,
+	}
+	for _, p := range points {
+		if p.Total != want[p.Bucket] {
+			t.Errorf("bucket %s: got %v, want %v", p.Bucket, p.Total, want[p.Bucket])
+		}
+	}
+}
+
+func TestTrendMonthlyBucketing(t *testing.T) {
+	expenses := []*model.Expense{
+		{Amount: 100, Date: mustCivilDate("2026-01-15")},
+		{Amount: 50, Date: mustCivilDate("2026-03-02")},
+	}
+	from, to := mustDate("2026-01-01"), mustDate("2026-04-01")
+
+	points := Trend(expenses, from, to, GranularityMonth, settings.Default())
+
+	if len(points) != 3 {
+		t.Fatalf("expected 3 monthly buckets, got %d", len(points))
+	}
+	if points[0].Bucket != "2026-01" || points[0].Total != 100 {
+		t.Errorf("unexpected first bucket: %+v", points[0])
+	}
+	if points[1].Total != 0 {
+		t.Errorf("expected february bucket to be zero, got %v", points[1].Total)
+	}
+	if points[2].Bucket != "2026-03" || points[2].Total != 50 {
+		t.Errorf("unexpected third bucket: %+v", points[2])
+	}
+}
@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// WeekdayAverage is the average spend for a single day of the week.
+type WeekdayAverage struct {
+	Weekday string  `json:"weekday"`
+	Average float64 `json:"average"`
+}
+
+// HourAverage is the average spend for a single hour of the day.
+type HourAverage struct {
+	Hour    int     `json:"hour"`
+	Average float64 `json:"average"`
+}
+
+// ByWeekdayAndHour breaks down average spend per weekday and per hour of
+// day, so callers can spot patterns like elevated Friday spending.
+func ByWeekdayAndHour(expenses []*model.Expense) ([]WeekdayAverage, []HourAverage) {
+	var weekdaySums [7]money.Money
+	var weekdayCounts [7]int
+	var hourSums [24]money.Money
+	var hourCounts [24]int
+
+	for _, e := range expenses {
+		wd := int(e.Date.Time().Weekday())
+		weekdaySums[wd] += e.Amount
+		weekdayCounts[wd]++
+
+		// Date has no time-of-day component (see internal/civildate), so the
+		// hour-of-day breakdown uses CreatedAt, when the entry was actually
+		// recorded, instead.
+		hourSums[e.CreatedAt.Hour()] += e.Amount
+		hourCounts[e.CreatedAt.Hour()]++
+	}
+
+	weekdays := make([]WeekdayAverage, 7)
+	for i := 0; i < 7; i++ {
+		var avg float64
+		if weekdayCounts[i] > 0 {
+			avg = weekdaySums[i].Float64() / float64(weekdayCounts[i])
+		}
+		weekdays[i] = WeekdayAverage{Weekday: time.Weekday(i).String(), Average: avg}
+	}
+
+	hours := make([]HourAverage, 24)
+	for i := 0; i < 24; i++ {
+		var avg float64
+		if hourCounts[i] > 0 {
+			avg = hourSums[i].Float64() / float64(hourCounts[i])
+		}
+		hours[i] = HourAverage{Hour: i, Average: avg}
+	}
+
+	return weekdays, hours
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddAccount inserts a, overwriting any existing account with the same ID.
+func (s *Store) AddAccount(ctx context.Context, a *model.Account) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[a.ID] = a
+	return s.save(ctx)
+}
+
+// GetAccount returns the account with the given ID, or false if none
+// exists.
+func (s *Store) GetAccount(id string) (*model.Account, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	a, ok := s.accounts[id]
+	return a, ok
+}
+
+// ListAccounts returns accounts ordered by creation time. Inactive accounts
+// are included only if includeInactive is true.
+func (s *Store) ListAccounts(includeInactive bool) []*model.Account {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Account, 0, len(s.accounts))
+	for _, a := range s.accounts {
+		if a.Active || includeInactive {
+			list = append(list, a)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteAccount permanently removes the account with the given ID.
+func (s *Store) DeleteAccount(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.accounts[id]; !ok {
+		return nil
+	}
+	delete(s.accounts, id)
+	return s.save(ctx)
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// aggregates holds running per-day and per-category totals, maintained
+// incrementally as expenses are added and removed so stats endpoints don't
+// have to rescan every expense on every request. Totals are keyed on the
+// expense's UTC calendar day; callers that need a different timezone still
+// scan List() directly.
+type aggregates struct {
+	dailyTotals    map[string]money.Money
+	categoryTotals map[string]money.Money
+}
+
+func newAggregates() aggregates {
+	return aggregates{
+		dailyTotals:    make(map[string]money.Money),
+		categoryTotals: make(map[string]money.Money),
+	}
+}
+
+func (a *aggregates) add(e *model.Expense) {
+	a.dailyTotals[e.Date.String()] += e.Amount
+	a.categoryTotals[e.Category] += e.Amount
+}
+
+func (a *aggregates) remove(e *model.Expense) {
+	day := e.Date.String()
+	a.dailyTotals[day] -= e.Amount
+	a.categoryTotals[e.Category] -= e.Amount
+}
+
+// DailyTotals returns a snapshot of per-day totals, keyed by UTC date.
+func (s *Store) DailyTotals() map[string]money.Money {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return cloneMoneyMap(s.aggregates.dailyTotals)
+}
+
+// CategoryTotals returns a snapshot of all-time per-category totals.
+func (s *Store) CategoryTotals() map[string]money.Money {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return cloneMoneyMap(s.aggregates.categoryTotals)
+}
+
+func cloneMoneyMap(m map[string]money.Money) map[string]money.Money {
+	out := make(map[string]money.Money, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
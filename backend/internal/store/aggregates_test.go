@@ -0,0 +1,41 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestAggregatesTrackAddAndDelete(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "expenses.db"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	ctx := context.Background()
+
+	e := &model.Expense{ID: "1", Amount: 1250, Category: "food", Date: civildate.New(2026, time.January, 5)}
+	if err := s.Add(ctx, e); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if got := s.DailyTotals()["2026-01-05"]; got != 1250 {
+		t.Errorf("daily total = %v, want 1250", got)
+	}
+	if got := s.CategoryTotals()["food"]; got != 1250 {
+		t.Errorf("category total = %v, want 1250", got)
+	}
+
+	if err := s.Delete(ctx, "1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if got := s.DailyTotals()["2026-01-05"]; got != 0 {
+		t.Errorf("daily total after delete = %v, want 0", got)
+	}
+	if got := s.CategoryTotals()["food"]; got != 0 {
+		t.Errorf("category total after delete = %v, want 0", got)
+	}
+}
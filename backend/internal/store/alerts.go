@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddAlertRule inserts rule, overwriting any existing rule with the same
+// ID.
+func (s *Store) AddAlertRule(ctx context.Context, rule *model.AlertRule) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alertRules[rule.ID] = rule
+	return s.save(ctx)
+}
+
+// ListAlertRules returns alert rules ordered by creation time. Inactive
+// rules are included only if includeInactive is true.
+func (s *Store) ListAlertRules(includeInactive bool) []*model.AlertRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.AlertRule, 0, len(s.alertRules))
+	for _, rule := range s.alertRules {
+		if rule.Active || includeInactive {
+			list = append(list, rule)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteAlertRule permanently removes the rule with the given ID.
+func (s *Store) DeleteAlertRule(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.alertRules[id]; !ok {
+		return nil
+	}
+	delete(s.alertRules, id)
+	return s.save(ctx)
+}
+
+// RecordAlertEvent appends event to the alert history, evicting the oldest
+// entries beyond maxAlertEvents, and persists the triggering rule's updated
+// LastFiredPeriod alongside it.
+func (s *Store) RecordAlertEvent(ctx context.Context, rule *model.AlertRule, event *model.AlertEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alertRules[rule.ID] = rule
+	s.alertEvents = append(s.alertEvents, event)
+	if len(s.alertEvents) > maxAlertEvents {
+		s.alertEvents = s.alertEvents[len(s.alertEvents)-maxAlertEvents:]
+	}
+	return s.save(ctx)
+}
+
+// ListAlertEvents returns alert history, most recent first.
+func (s *Store) ListAlertEvents() []*model.AlertEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.AlertEvent, len(s.alertEvents))
+	for i, e := range s.alertEvents {
+		list[len(list)-1-i] = e
+	}
+	return list
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddAPIKey inserts k, overwriting any existing key with the same ID.
+func (s *Store) AddAPIKey(ctx context.Context, k *model.APIKey) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.apiKeys[k.ID] = k
+	return s.save(ctx)
+}
+
+// GetAPIKeyByHash returns the non-revoked key matching hash, or false if
+// none exists.
+func (s *Store) GetAPIKeyByHash(hash string) (*model.APIKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, k := range s.apiKeys {
+		if k.KeyHash == hash && k.RevokedAt == nil {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+// ListAPIKeys returns userID's keys ordered by creation time. The key
+// hashes are included; handlers must not echo them back to callers.
+func (s *Store) ListAPIKeys(userID string) []*model.APIKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.APIKey, 0, len(s.apiKeys))
+	for _, k := range s.apiKeys {
+		if k.UserID == userID {
+			list = append(list, k)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// GetAPIKey returns the key with the given ID, or false if none exists.
+func (s *Store) GetAPIKey(id string) (*model.APIKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	k, ok := s.apiKeys[id]
+	return k, ok
+}
+
+// RevokeAPIKey marks the key with the given ID as revoked, so it can no
+// longer authenticate. Keys are kept, not deleted, so past use remains
+// auditable.
+func (s *Store) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k, ok := s.apiKeys[id]
+	if !ok {
+		return nil
+	}
+	k.RevokedAt = &revokedAt
+	return s.save(ctx)
+}
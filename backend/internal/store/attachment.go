@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddAttachment inserts a, overwriting any existing attachment with the same
+// ID.
+func (s *Store) AddAttachment(ctx context.Context, a *model.Attachment) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attachments[a.ID] = a
+	return s.save(ctx)
+}
+
+// GetAttachment returns the attachment with the given ID, or false if none
+// exists.
+func (s *Store) GetAttachment(id string) (*model.Attachment, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	a, ok := s.attachments[id]
+	return a, ok
+}
+
+// ListAttachmentsForExpense returns attachments on expenseID, oldest first.
+func (s *Store) ListAttachmentsForExpense(expenseID string) []*model.Attachment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var list []*model.Attachment
+	for _, a := range s.attachments {
+		if a.ExpenseID == expenseID {
+			list = append(list, a)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// ListAttachments returns every attachment record, in no particular order.
+// It exists for the GC sweep (internal/attachments.Sweep), which needs the
+// full set regardless of owner or expense.
+func (s *Store) ListAttachments() []*model.Attachment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Attachment, 0, len(s.attachments))
+	for _, a := range s.attachments {
+		list = append(list, a)
+	}
+	return list
+}
+
+// AttachmentUsageBytes sums the size of every attachment owned by userID,
+// for enforcing internal/attachments.MaxBytesPerUser and reporting usage.
+func (s *Store) AttachmentUsageBytes(userID string) int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var total int64
+	for _, a := range s.attachments {
+		if a.UserID == userID {
+			total += a.Size
+		}
+	}
+	return total
+}
+
+// DeleteAttachment permanently removes the attachment record with the given
+// ID. The caller is responsible for also deleting the underlying blob (see
+// internal/attachments.Delete).
+func (s *Store) DeleteAttachment(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.attachments[id]; !ok {
+		return nil
+	}
+	delete(s.attachments, id)
+	return s.save(ctx)
+}
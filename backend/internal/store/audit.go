@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// RecordAuditEvent appends event to the audit log, evicting the oldest
+// entries beyond maxAuditEvents.
+func (s *Store) RecordAuditEvent(ctx context.Context, event *model.AuditEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.auditEvents = append(s.auditEvents, event)
+	if len(s.auditEvents) > maxAuditEvents {
+		s.auditEvents = s.auditEvents[len(s.auditEvents)-maxAuditEvents:]
+	}
+	return s.save(ctx)
+}
+
+// ListAuditEvents returns the audit log, most recent first.
+func (s *Store) ListAuditEvents() []*model.AuditEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.AuditEvent, len(s.auditEvents))
+	for i, e := range s.auditEvents {
+		list[len(list)-1-i] = e
+	}
+	return list
+}
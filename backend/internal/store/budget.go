@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddBudget inserts b, overwriting any existing budget with the same ID.
+func (s *Store) AddBudget(ctx context.Context, b *model.Budget) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.budgets[b.ID] = b
+	s.ensureCategory(b.Category)
+	return s.save(ctx)
+}
+
+// GetBudget returns the budget with the given ID, or false if none exists.
+func (s *Store) GetBudget(id string) (*model.Budget, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.budgets[id]
+	return b, ok
+}
+
+// ListBudgets returns budgets ordered by creation time. Inactive budgets are
+// included only if includeInactive is true.
+func (s *Store) ListBudgets(includeInactive bool) []*model.Budget {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Budget, 0, len(s.budgets))
+	for _, b := range s.budgets {
+		if b.Active || includeInactive {
+			list = append(list, b)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteBudget permanently removes the budget with the given ID.
+func (s *Store) DeleteBudget(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.budgets[id]; !ok {
+		return nil
+	}
+	delete(s.budgets, id)
+	return s.save(ctx)
+}
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// defaultPalette is the set of colors auto-assigned to a category the first
+// time it's seen, chosen deterministically by name so the same category
+// gets the same color across restarts until the user overrides it.
+var defaultPalette = []string{
+	"#ef4444", "#f97316", "#f59e0b", "#84cc16", "#22c55e",
+	"#14b8a6", "#06b6d4", "#3b82f6", "#8b5cf6", "#ec4899",
+}
+
+// defaultIcon is used for every auto-discovered category until the user
+// assigns a more specific one.
+const defaultIcon = "tag"
+
+func defaultColorFor(name string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return defaultPalette[h.Sum32()%uint32(len(defaultPalette))]
+}
+
+// ensureCategory records name in the category registry with a default
+// color and icon if it hasn't been seen before. Callers must hold s.mu.
+func (s *Store) ensureCategory(name string) {
+	if name == "" {
+		return
+	}
+	if _, ok := s.categories[name]; ok {
+		return
+	}
+	s.categories[name] = &model.Category{
+		ID:    idgen.New(),
+		Name:  name,
+		Color: defaultColorFor(name),
+		Icon:  defaultIcon,
+	}
+}
+
+// Category returns the display metadata and expense defaults recorded for
+// name, if it's been seen before.
+func (s *Store) Category(name string) (*model.Category, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.categories[name]
+	return c, ok
+}
+
+// Categories returns every known category, sorted by name, with its display
+// color and icon.
+func (s *Store) Categories() []*model.Category {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Category, 0, len(s.categories))
+	for _, c := range s.categories {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// UpdateCategoryStyle sets the color and/or icon for a category (creating it
+// with defaults first if it hasn't been seen yet) and persists the change.
+// Passing an empty color or icon leaves that field unchanged.
+func (s *Store) UpdateCategoryStyle(ctx context.Context, name, color, icon string) (*model.Category, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ensureCategory(name)
+	c := s.categories[name]
+	if color != "" {
+		c.Color = color
+	}
+	if icon != "" {
+		c.Icon = icon
+	}
+	return c, s.save(ctx)
+}
+
+// UpdateCategoryDefaults sets the fields auto-applied to a new expense
+// created in this category (creating the category with display defaults
+// first if it hasn't been seen yet) and persists the change. Passing an
+// empty note, empty payment method, or zero tax rate leaves that field
+// unchanged, matching UpdateCategoryStyle's treatment of zero values.
+func (s *Store) UpdateCategoryDefaults(ctx context.Context, name, note, paymentMethod string, taxRate float64) (*model.Category, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ensureCategory(name)
+	c := s.categories[name]
+	if note != "" {
+		c.DefaultNote = note
+	}
+	if paymentMethod != "" {
+		c.DefaultPaymentMethod = paymentMethod
+	}
+	if taxRate != 0 {
+		c.DefaultTaxRate = taxRate
+	}
+	return c, s.save(ctx)
+}
+
+// UpdateCategoryRetention sets the minimum age (in days) an expense in this
+// category must reach before internal/retention's expense purge is allowed
+// to remove it (creating the category with defaults first if it hasn't been
+// seen yet) and persists the change. Passing 0 leaves the current value
+// unchanged, matching UpdateCategoryStyle's treatment of zero values.
+func (s *Store) UpdateCategoryRetention(ctx context.Context, name string, days int) (*model.Category, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ensureCategory(name)
+	c := s.categories[name]
+	if days != 0 {
+		c.RetentionDays = days
+	}
+	return c, s.save(ctx)
+}
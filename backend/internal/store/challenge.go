@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddChallenge inserts c, overwriting any existing challenge with the same
+// ID.
+func (s *Store) AddChallenge(ctx context.Context, c *model.Challenge) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.challenges[c.ID] = c
+	return s.save(ctx)
+}
+
+// GetChallenge returns the challenge with the given ID.
+func (s *Store) GetChallenge(id string) (*model.Challenge, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.challenges[id]
+	return c, ok
+}
+
+// ListChallenges returns challenges ordered by creation time. Inactive
+// challenges are included only if includeInactive is true.
+func (s *Store) ListChallenges(includeInactive bool) []*model.Challenge {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Challenge, 0, len(s.challenges))
+	for _, c := range s.challenges {
+		if c.Active || includeInactive {
+			list = append(list, c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteChallenge permanently removes the challenge with the given ID.
+func (s *Store) DeleteChallenge(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.challenges[id]; !ok {
+		return nil
+	}
+	delete(s.challenges, id)
+	return s.save(ctx)
+}
@@ -0,0 +1,91 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddDebt inserts d, overwriting any existing debt with the same ID.
+func (s *Store) AddDebt(ctx context.Context, d *model.Debt) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.debts[d.ID] = d
+	return s.save(ctx)
+}
+
+// GetDebt returns the debt with the given ID, or false if none exists.
+func (s *Store) GetDebt(id string) (*model.Debt, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d, ok := s.debts[id]
+	return d, ok
+}
+
+// ListDebts returns debts ordered by creation time. Inactive debts are
+// included only if includeInactive is true.
+func (s *Store) ListDebts(includeInactive bool) []*model.Debt {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Debt, 0, len(s.debts))
+	for _, d := range s.debts {
+		if d.Active || includeInactive {
+			list = append(list, d)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteDebt permanently removes the debt with the given ID.
+func (s *Store) DeleteDebt(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.debts[id]; !ok {
+		return nil
+	}
+	delete(s.debts, id)
+	return s.save(ctx)
+}
+
+// ApplyDebtPayment reduces debtID's balance by payment.Amount and records
+// payment in the debt's payment history, in a single save.
+func (s *Store) ApplyDebtPayment(ctx context.Context, payment *model.DebtPayment) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.debts[payment.DebtID]
+	if !ok {
+		return fmt.Errorf("debt %s not found", payment.DebtID)
+	}
+	d.Balance -= payment.Amount
+	if d.Balance < 0 {
+		d.Balance = 0
+	}
+	s.debtPayments = append(s.debtPayments, payment)
+	return s.save(ctx)
+}
+
+// ListDebtPayments returns payment history for debtID, most recent first.
+func (s *Store) ListDebtPayments(debtID string) []*model.DebtPayment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var list []*model.DebtPayment
+	for _, p := range s.debtPayments {
+		if p.DebtID == debtID {
+			list = append(list, p)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.After(list[j].CreatedAt) })
+	return list
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddDraftExpense inserts d, overwriting any existing draft with the same
+// ID.
+func (s *Store) AddDraftExpense(ctx context.Context, d *model.DraftExpense) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.drafts[d.ID] = d
+	return s.save(ctx)
+}
+
+// GetDraftExpense returns the draft with the given ID, or false if none
+// exists.
+func (s *Store) GetDraftExpense(id string) (*model.DraftExpense, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d, ok := s.drafts[id]
+	return d, ok
+}
+
+// ListDraftExpenses returns drafts ordered by creation time, oldest first.
+func (s *Store) ListDraftExpenses() []*model.DraftExpense {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.DraftExpense, 0, len(s.drafts))
+	for _, d := range s.drafts {
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteDraftExpense permanently removes the draft with the given ID, used
+// both to reject a draft and to clean up after it's confirmed.
+func (s *Store) DeleteDraftExpense(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.drafts[id]; !ok {
+		return nil
+	}
+	delete(s.drafts, id)
+	return s.save(ctx)
+}
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"log/slog"
+
+	"halclarke97/budgetapp/backend/internal/crypto"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// encryptedExpenseCopy returns a shallow copy of e with Note and Merchant
+// encrypted under its owner's derived key, for writing to disk. The copy
+// leaves e itself untouched, so in-memory consumers keep working with
+// plaintext. Expenses with no UserID predate user accounts and are left in
+// plaintext, since there's no key to encrypt them under.
+func (s *Store) encryptedExpenseCopy(e *model.Expense) (*model.Expense, error) {
+	if s.encryptionKey == nil || e.UserID == "" {
+		return e, nil
+	}
+	key := crypto.DeriveUserKey(s.encryptionKey, e.UserID)
+	note, err := crypto.EncryptField(key, e.Note)
+	if err != nil {
+		return nil, err
+	}
+	merchant, err := crypto.EncryptField(key, e.Merchant)
+	if err != nil {
+		return nil, err
+	}
+	copied := *e
+	copied.Note, copied.Merchant = note, merchant
+	return &copied, nil
+}
+
+// decryptExpenseFields decrypts e's Note and Merchant in place, after
+// loading from disk.
+func (s *Store) decryptExpenseFields(e *model.Expense) {
+	if s.encryptionKey == nil || e.UserID == "" {
+		return
+	}
+	key := crypto.DeriveUserKey(s.encryptionKey, e.UserID)
+	if note, err := crypto.DecryptField(key, e.Note); err == nil {
+		e.Note = note
+	} else {
+		slog.Error("store: decrypt expense note failed", "expense_id", e.ID, "error", err)
+	}
+	if merchant, err := crypto.DecryptField(key, e.Merchant); err == nil {
+		e.Merchant = merchant
+	} else {
+		slog.Error("store: decrypt expense merchant failed", "expense_id", e.ID, "error", err)
+	}
+}
+
+// encryptedRecurringCopy is encryptedExpenseCopy for RecurringPattern.
+func (s *Store) encryptedRecurringCopy(p *model.RecurringPattern) (*model.RecurringPattern, error) {
+	if s.encryptionKey == nil || p.UserID == "" {
+		return p, nil
+	}
+	key := crypto.DeriveUserKey(s.encryptionKey, p.UserID)
+	note, err := crypto.EncryptField(key, p.Note)
+	if err != nil {
+		return nil, err
+	}
+	merchant, err := crypto.EncryptField(key, p.Merchant)
+	if err != nil {
+		return nil, err
+	}
+	copied := *p
+	copied.Note, copied.Merchant = note, merchant
+	return &copied, nil
+}
+
+// decryptRecurringFields is decryptExpenseFields for RecurringPattern.
+func (s *Store) decryptRecurringFields(p *model.RecurringPattern) {
+	if s.encryptionKey == nil || p.UserID == "" {
+		return
+	}
+	key := crypto.DeriveUserKey(s.encryptionKey, p.UserID)
+	if note, err := crypto.DecryptField(key, p.Note); err == nil {
+		p.Note = note
+	} else {
+		slog.Error("store: decrypt recurring pattern note failed", "recurring_id", p.ID, "error", err)
+	}
+	if merchant, err := crypto.DecryptField(key, p.Merchant); err == nil {
+		p.Merchant = merchant
+	} else {
+		slog.Error("store: decrypt recurring pattern merchant failed", "recurring_id", p.ID, "error", err)
+	}
+}
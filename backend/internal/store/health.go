@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// Revision returns how many times the store has been successfully
+// persisted to disk, so callers can detect when the data has changed.
+func (s *Store) Revision() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.revision
+}
+
+// LastPersistedAt returns when the store was last successfully written to
+// disk. It's the zero Time if nothing has been persisted yet this process.
+func (s *Store) LastPersistedAt() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastPersistedAt
+}
+
+// BeginRestore marks the store as mid-restore, so readiness checks fail
+// until EndRestore is called. Intended for future backup-restore or
+// migration tooling that needs to hold traffic back while it works.
+func (s *Store) BeginRestore() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.restoring = true
+}
+
+// EndRestore clears the in-progress restore flag set by BeginRestore.
+func (s *Store) EndRestore() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.restoring = false
+}
+
+// Restoring reports whether a restore or migration is currently in
+// progress.
+func (s *Store) Restoring() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.restoring
+}
+
+// CheckWritable reports whether the data file's directory is currently
+// writable, by writing and removing a small probe file next to it.
+func (s *Store) CheckWritable() error {
+	s.mu.RLock()
+	path := s.path
+	s.mu.RUnlock()
+	probe := path + ".healthcheck"
+	if err := os.WriteFile(probe, []byte("ok"), 0o644); err != nil {
+		return fmt.Errorf("data directory not writable: %w", err)
+	}
+	return os.Remove(probe)
+}
+
+// RecordCounts returns how many records of each kind are currently in
+// memory, for surfacing on debug/diagnostic endpoints.
+func (s *Store) RecordCounts() map[string]int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return map[string]int{
+		"expenses":         len(s.expenses),
+		"recurring":        len(s.recurring),
+		"linked_accounts":  len(s.linkedAccounts),
+		"budgets":          len(s.budgets),
+		"income":           len(s.income),
+		"accounts":         len(s.accounts),
+		"debts":            len(s.debts),
+		"projects":         len(s.projects),
+		"reminders":        len(s.reminders),
+		"users":            len(s.users),
+		"api_keys":         len(s.apiKeys),
+		"audit_events":     len(s.auditEvents),
+		"challenges":       len(s.challenges),
+		"sweep_logs":       len(s.sweepLogs),
+		"period_closeouts": len(s.periodCloseouts),
+		"sync_blobs":       len(s.syncBlobs),
+		"webhooks":         len(s.webhooks),
+	}
+}
+
+// Compact forces a fresh full rewrite of the data file. Every persist
+// already rewrites the whole file (see save), so this doesn't shrink
+// anything a normal mutation wouldn't already have; it exists for
+// operators who want to force a rewrite on demand, e.g. right after a bulk
+// purge, without waiting for the next mutation.
+func (s *Store) Compact(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.save(ctx)
+}
+
+// PendingBatchWrites returns how many expenses are currently being applied
+// by an in-flight AddBatch or Merge call (a bulk import or bank sync in
+// progress). It's a backpressure signal for bulk endpoints: a caller
+// polling this during a large import sees the backlog shrink as the batch
+// is persisted, rather than a single opaque request hanging with no
+// visibility into its progress.
+func (s *Store) PendingBatchWrites() int64 {
+	return atomic.LoadInt64(&s.pendingBatchWrites)
+}
+
+// DataFileSize returns the size in bytes of the on-disk data file, or 0 if
+// it doesn't exist yet (e.g. before the first save).
+func (s *Store) DataFileSize() int64 {
+	s.mu.RLock()
+	path := s.path
+	s.mu.RUnlock()
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddIncome inserts inc, overwriting any existing income with the same ID.
+// Reconciled income is protected from being silently replaced.
+func (s *Store) AddIncome(ctx context.Context, inc *model.Income) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.income[inc.ID]; ok && old.Reconciled {
+		return fmt.Errorf("income %s is reconciled and cannot be modified", inc.ID)
+	}
+	s.income[inc.ID] = inc
+	return s.save(ctx)
+}
+
+// GetIncome returns the income entry with the given ID, or false if none
+// exists.
+func (s *Store) GetIncome(id string) (*model.Income, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	inc, ok := s.income[id]
+	return inc, ok
+}
+
+// ListIncome returns income entries ordered by date, oldest first.
+func (s *Store) ListIncome() []*model.Income {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Income, 0, len(s.income))
+	for _, inc := range s.income {
+		list = append(list, inc)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Date.Before(list[j].Date) })
+	return list
+}
+
+// DeleteIncome permanently removes the income entry with the given ID.
+func (s *Store) DeleteIncome(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.income[id]; !ok {
+		return nil
+	}
+	delete(s.income, id)
+	return s.save(ctx)
+}
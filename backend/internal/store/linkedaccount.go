@@ -0,0 +1,58 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddLinkedAccount inserts a, overwriting any existing account with the
+// same ID.
+func (s *Store) AddLinkedAccount(ctx context.Context, a *model.LinkedAccount) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.linkedAccounts[a.ID] = a
+	return s.save(ctx)
+}
+
+// GetLinkedAccount returns the account with the given ID, or false if none
+// exists.
+func (s *Store) GetLinkedAccount(id string) (*model.LinkedAccount, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	a, ok := s.linkedAccounts[id]
+	return a, ok
+}
+
+// ListLinkedAccounts returns accounts ordered by creation time. Inactive
+// (unlinked) accounts are included only if includeInactive is true.
+func (s *Store) ListLinkedAccounts(includeInactive bool) []*model.LinkedAccount {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.LinkedAccount, 0, len(s.linkedAccounts))
+	for _, a := range s.linkedAccounts {
+		if a.Active || includeInactive {
+			list = append(list, a)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteLinkedAccount permanently removes the account with the given ID.
+func (s *Store) DeleteLinkedAccount(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.linkedAccounts[id]; !ok {
+		return nil
+	}
+	delete(s.linkedAccounts, id)
+	return s.save(ctx)
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/importer"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// MergeResult summarizes what happened when merging another data file's
+// expenses into this store.
+type MergeResult struct {
+	Imported int `json:"imported"`
+	Renamed  int `json:"renamed_ids"`
+	Skipped  int `json:"skipped_duplicates"`
+}
+
+// Merge reads another store's data file from r and folds its expenses into
+// this store, for a user consolidating data recorded on two devices that
+// were never pointed at the same data file. Expenses whose ID collides with
+// an existing one are assigned a fresh ID rather than overwriting one;
+// expenses that look like duplicates of ones already present (see
+// internal/importer.IsDuplicate) are skipped. Other record types (recurring
+// patterns, budgets, settings, and the rest) aren't merged: expenses are the
+// only data this app dedupes by fingerprint, and blindly merging everything
+// else risks doubling up recurring patterns and reminders.
+func (s *Store) Merge(ctx context.Context, r io.Reader) (MergeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return MergeResult{}, err
+	}
+	var doc document
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return MergeResult{}, fmt.Errorf("decode merge file: %w", err)
+	}
+
+	atomic.AddInt64(&s.pendingBatchWrites, int64(len(doc.Expenses)))
+	defer atomic.AddInt64(&s.pendingBatchWrites, -int64(len(doc.Expenses)))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing := make([]*model.Expense, 0, len(s.expenses))
+	for _, e := range s.expenses {
+		existing = append(existing, e)
+	}
+
+	var result MergeResult
+	for _, e := range doc.Expenses {
+		if importer.IsDuplicate(existing, e) {
+			result.Skipped++
+			continue
+		}
+		if err := s.checkPeriodUnlocked(e.Date.Time()); err != nil {
+			result.Skipped++
+			continue
+		}
+		if _, collides := s.expenses[e.ID]; collides || e.ID == "" {
+			e.ID = idgen.New()
+			result.Renamed++
+		}
+		e.Fingerprint = importer.Fingerprint(e.Date, e.Amount, e.Merchant)
+		e.UpdatedAt = time.Now()
+		s.expenses[e.ID] = e
+		s.aggregates.add(e)
+		s.ensureCategory(e.Category)
+		existing = append(existing, e)
+		result.Imported++
+	}
+	if result.Imported == 0 {
+		return result, nil
+	}
+	return result, s.save(ctx)
+}
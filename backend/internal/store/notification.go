@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddChannel inserts c, overwriting any existing channel with the same ID.
+func (s *Store) AddChannel(ctx context.Context, c *model.NotificationChannel) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.channels[c.ID] = c
+	return s.save(ctx)
+}
+
+// ListChannels returns notification channels ordered by creation time.
+// Inactive channels are included only if includeInactive is true.
+func (s *Store) ListChannels(includeInactive bool) []*model.NotificationChannel {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.NotificationChannel, 0, len(s.channels))
+	for _, c := range s.channels {
+		if c.Active || includeInactive {
+			list = append(list, c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteChannel permanently removes the channel with the given ID.
+func (s *Store) DeleteChannel(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.channels[id]; !ok {
+		return nil
+	}
+	delete(s.channels, id)
+	return s.save(ctx)
+}
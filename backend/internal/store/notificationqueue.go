@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// QueueNotification appends n to the pending-notification queue, evicting
+// the oldest entries beyond maxQueuedNotifications.
+func (s *Store) QueueNotification(ctx context.Context, n *model.QueuedNotification) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queuedNotifications = append(s.queuedNotifications, n)
+	if len(s.queuedNotifications) > maxQueuedNotifications {
+		s.queuedNotifications = s.queuedNotifications[len(s.queuedNotifications)-maxQueuedNotifications:]
+	}
+	return s.save(ctx)
+}
+
+// ListQueuedNotifications returns the pending-notification queue, oldest
+// first (the order they should be summarized in).
+func (s *Store) ListQueuedNotifications() []*model.QueuedNotification {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.QueuedNotification, len(s.queuedNotifications))
+	copy(list, s.queuedNotifications)
+	return list
+}
+
+// ClearQueuedNotifications empties the pending-notification queue after it's
+// been flushed.
+func (s *Store) ClearQueuedNotifications(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queuedNotifications) == 0 {
+		return nil
+	}
+	s.queuedNotifications = nil
+	return s.save(ctx)
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// ClosePeriod locks all expenses dated before cutoff against edits and
+// deletion, e.g. after a month's books have been reconciled and used for a
+// tax filing. Closing a later cutoff than the current one is allowed
+// (extending the closed range); closing an earlier one is not, since that
+// would silently reopen already-closed dates without going through
+// ReopenPeriod.
+func (s *Store) ClosePeriod(ctx context.Context, cutoff time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.periodLockedBefore.IsZero() && cutoff.Before(s.periodLockedBefore) {
+		return fmt.Errorf("cutoff %s is before the current lock at %s; reopen the period first", cutoff.Format("2006-01-02"), s.periodLockedBefore.Format("2006-01-02"))
+	}
+	s.periodLockedBefore = cutoff
+	return s.save(ctx)
+}
+
+// ReopenPeriod clears the lock set by ClosePeriod, allowing edits to
+// previously closed dates again.
+func (s *Store) ReopenPeriod(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.periodLockedBefore = time.Time{}
+	return s.save(ctx)
+}
+
+// PeriodLockedBefore returns the current closing cutoff, or the zero time
+// if no period is closed.
+func (s *Store) PeriodLockedBefore() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.periodLockedBefore
+}
+
+// periodCloseoutKey combines userID and month so each user has their own
+// close-out journal entry per month instead of one user's close-out
+// overwriting another's for the same calendar month.
+func periodCloseoutKey(userID, month string) string {
+	return userID + "|" + month
+}
+
+// AddPeriodCloseout records c as the close-out journal entry for its
+// UserID and Month, overwriting any existing entry for that user and month
+// so re-closing an already-closed month updates the journal rather than
+// duplicating it.
+func (s *Store) AddPeriodCloseout(ctx context.Context, c *model.PeriodCloseout) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.periodCloseouts[periodCloseoutKey(c.UserID, c.Month)] = c
+	return s.save(ctx)
+}
+
+// ListPeriodCloseouts returns every recorded close-out, most recent month
+// first.
+func (s *Store) ListPeriodCloseouts() []*model.PeriodCloseout {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.PeriodCloseout, 0, len(s.periodCloseouts))
+	for _, c := range s.periodCloseouts {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Month > list[j].Month })
+	return list
+}
+
+// checkPeriodUnlocked returns an error if date falls in the closed period.
+// Callers must hold s.mu.
+func (s *Store) checkPeriodUnlocked(date time.Time) error {
+	if !s.periodLockedBefore.IsZero() && date.Before(s.periodLockedBefore) {
+		return fmt.Errorf("period closed before %s: expense dated %s cannot be modified", s.periodLockedBefore.Format("2006-01-02"), date.Format("2006-01-02"))
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddProject inserts p, overwriting any existing project with the same ID.
+func (s *Store) AddProject(ctx context.Context, p *model.Project) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projects[p.ID] = p
+	return s.save(ctx)
+}
+
+// GetProject returns the project with the given ID, or false if none
+// exists.
+func (s *Store) GetProject(id string) (*model.Project, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.projects[id]
+	return p, ok
+}
+
+// ListProjects returns projects ordered by creation time. Inactive projects
+// are included only if includeInactive is true.
+func (s *Store) ListProjects(includeInactive bool) []*model.Project {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Project, 0, len(s.projects))
+	for _, p := range s.projects {
+		if p.Active || includeInactive {
+			list = append(list, p)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// UpdateProjectRetention sets the minimum age (in days) an expense linked to
+// this project must reach before internal/retention's expense purge is
+// allowed to remove it, and persists the change. Passing 0 leaves the
+// current value unchanged, matching UpdateCategoryRetention's treatment of
+// zero values.
+func (s *Store) UpdateProjectRetention(ctx context.Context, id string, days int) (*model.Project, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.projects[id]
+	if !ok {
+		return nil, fmt.Errorf("project %s not found", id)
+	}
+	if days != 0 {
+		p.RetentionDays = days
+	}
+	return p, s.save(ctx)
+}
+
+// DeleteProject permanently removes the project with the given ID.
+func (s *Store) DeleteProject(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.projects[id]; !ok {
+		return nil
+	}
+	delete(s.projects, id)
+	return s.save(ctx)
+}
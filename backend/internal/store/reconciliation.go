@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+)
+
+// ReconcileAccount matches account's tracked balance as of statementDate
+// against a bank statement: every expense and income entry attributed to
+// the account dated on or before statementDate is marked reconciled (and
+// thereafter protected from silent edits), and the discrepancy between the
+// statement balance and budgetapp's computed balance is reported so the
+// user can investigate a missing or wrong entry.
+func (s *Store) ReconcileAccount(ctx context.Context, accountID string, statementBalance money.Money, statementDate time.Time) (*model.Reconciliation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account, ok := s.accounts[accountID]
+	if !ok {
+		return nil, fmt.Errorf("account %s not found", accountID)
+	}
+
+	computed := account.OpeningBalance
+	reconciledCount := 0
+	for _, e := range s.expenses {
+		if e.AccountID != accountID || e.Date.Time().After(statementDate) {
+			continue
+		}
+		computed -= e.Amount
+		if !e.Reconciled {
+			e.Reconciled = true
+			e.UpdatedAt = time.Now()
+			reconciledCount++
+		}
+	}
+	for _, inc := range s.income {
+		if inc.AccountID != accountID || inc.Date.After(statementDate) {
+			continue
+		}
+		computed += inc.Amount
+		if !inc.Reconciled {
+			inc.Reconciled = true
+			reconciledCount++
+		}
+	}
+
+	account.LastReconciledAt = statementDate
+	account.LastReconciledBalance = statementBalance
+
+	rec := &model.Reconciliation{
+		ID:               idgen.New(),
+		AccountID:        accountID,
+		StatementBalance: statementBalance,
+		StatementDate:    statementDate,
+		ComputedBalance:  computed,
+		Discrepancy:      statementBalance - computed,
+		ReconciledCount:  reconciledCount,
+		CreatedAt:        time.Now(),
+	}
+	s.reconciliations = append(s.reconciliations, rec)
+	if err := s.save(ctx); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// ListReconciliations returns reconciliation history for account, most
+// recent first.
+func (s *Store) ListReconciliations(accountID string) []*model.Reconciliation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var list []*model.Reconciliation
+	for _, r := range s.reconciliations {
+		if r.AccountID == accountID {
+			list = append(list, r)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.After(list[j].CreatedAt) })
+	return list
+}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestReconcileAccountMarksEntriesAndReportsDiscrepancy(t *testing.T) {
+	st, err := New(filepath.Join(t.TempDir(), "data.json"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	account := &model.Account{ID: "a1", Name: "Checking", OpeningBalance: 1000, Active: true, CreatedAt: time.Now()}
+	ctx := context.Background()
+	if err := st.AddAccount(ctx, account); err != nil {
+		t.Fatalf("AddAccount: %v", err)
+	}
+	e := &model.Expense{ID: "e1", AccountID: "a1", Amount: 100, Date: civildate.New(2026, time.March, 1)}
+	if err := st.Add(ctx, e); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	statementDate := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	rec, err := st.ReconcileAccount(ctx, "a1", 950, statementDate)
+	if err != nil {
+		t.Fatalf("ReconcileAccount: %v", err)
+	}
+	if rec.ComputedBalance != 900 {
+		t.Errorf("ComputedBalance = %v, want 900", rec.ComputedBalance)
+	}
+	if rec.Discrepancy != 50 {
+		t.Errorf("Discrepancy = %v, want 50", rec.Discrepancy)
+	}
+	if rec.ReconciledCount != 1 {
+		t.Errorf("ReconciledCount = %v, want 1", rec.ReconciledCount)
+	}
+
+	got, _ := st.Get("e1")
+	if !got.Reconciled {
+		t.Fatal("expected expense to be marked reconciled")
+	}
+	got.Amount = 200
+	if err := st.Add(ctx, got); err == nil {
+		t.Error("expected editing a reconciled expense to be rejected")
+	}
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddRecurring inserts p, overwriting any existing pattern with the same ID.
+func (s *Store) AddRecurring(ctx context.Context, p *model.RecurringPattern) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recurring[p.ID] = p
+	return s.save(ctx)
+}
+
+// GetRecurring returns the pattern with the given ID, or false if none
+// exists.
+func (s *Store) GetRecurring(id string) (*model.RecurringPattern, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.recurring[id]
+	return p, ok
+}
+
+// ListRecurring returns patterns ordered by creation time. Inactive
+// (deactivated) patterns are included only if includeInactive is true.
+func (s *Store) ListRecurring(includeInactive bool) []*model.RecurringPattern {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.RecurringPattern, 0, len(s.recurring))
+	for _, p := range s.recurring {
+		if p.Active || includeInactive {
+			list = append(list, p)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeactivateRecurring marks the pattern with the given ID inactive instead
+// of removing it, so it drops out of ListRecurring/Upcoming projections
+// while its history (past occurrences, generated expenses) stays intact.
+func (s *Store) DeactivateRecurring(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.recurring[id]
+	if !ok {
+		return nil
+	}
+	p.Active = false
+	p.UpdatedAt = time.Now()
+	return s.save(ctx)
+}
+
+// ActivateRecurring reverses DeactivateRecurring, marking the pattern with
+// the given ID active again so it reappears in ListRecurring/Upcoming
+// projections.
+func (s *Store) ActivateRecurring(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.recurring[id]
+	if !ok {
+		return nil
+	}
+	p.Active = true
+	p.UpdatedAt = time.Now()
+	return s.save(ctx)
+}
+
+// DeleteRecurring permanently removes the pattern with the given ID.
+func (s *Store) DeleteRecurring(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.recurring[id]; !ok {
+		return nil
+	}
+	delete(s.recurring, id)
+	return s.save(ctx)
+}
@@ -0,0 +1,58 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddReminder inserts rem, overwriting any existing reminder with the same
+// ID.
+func (s *Store) AddReminder(ctx context.Context, rem *model.Reminder) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reminders[rem.ID] = rem
+	return s.save(ctx)
+}
+
+// GetReminder returns the reminder with the given ID, or false if none
+// exists.
+func (s *Store) GetReminder(id string) (*model.Reminder, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rem, ok := s.reminders[id]
+	return rem, ok
+}
+
+// ListReminders returns reminders ordered by due date. Reminders already
+// notified are included only if includeNotified is true.
+func (s *Store) ListReminders(includeNotified bool) []*model.Reminder {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Reminder, 0, len(s.reminders))
+	for _, rem := range s.reminders {
+		if !rem.Notified || includeNotified {
+			list = append(list, rem)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].DueDate.Before(list[j].DueDate) })
+	return list
+}
+
+// DeleteReminder permanently removes the reminder with the given ID.
+func (s *Store) DeleteReminder(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.reminders[id]; !ok {
+		return nil
+	}
+	delete(s.reminders, id)
+	return s.save(ctx)
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeAuditEventsBefore removes audit events recorded before cutoff and
+// persists the change if anything was removed, returning how many were
+// purged.
+func (s *Store) PurgeAuditEventsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.auditEvents[:0:0]
+	removed := 0
+	for _, e := range s.auditEvents {
+		if e.CreatedAt.Before(cutoff) {
+			removed++
+			continue
+		}
+		kept = append(kept, e)
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+	s.auditEvents = kept
+	return removed, s.save(ctx)
+}
+
+// PurgeAlertEventsBefore removes alert events triggered before cutoff and
+// persists the change if anything was removed, returning how many were
+// purged.
+func (s *Store) PurgeAlertEventsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.alertEvents[:0:0]
+	removed := 0
+	for _, e := range s.alertEvents {
+		if e.TriggeredAt.Before(cutoff) {
+			removed++
+			continue
+		}
+		kept = append(kept, e)
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+	s.alertEvents = kept
+	return removed, s.save(ctx)
+}
+
+// PurgeSweepLogsBefore removes sweep logs started before cutoff and
+// persists the change if anything was removed, returning how many were
+// purged.
+func (s *Store) PurgeSweepLogsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.sweepLogs[:0:0]
+	removed := 0
+	for _, l := range s.sweepLogs {
+		if l.StartedAt.Before(cutoff) {
+			removed++
+			continue
+		}
+		kept = append(kept, l)
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+	s.sweepLogs = kept
+	return removed, s.save(ctx)
+}
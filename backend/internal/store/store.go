@@ -0,0 +1,760 @@
+// Package store provides a JSON-file-backed persistence layer for expenses.
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/importer"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/settings"
+)
+
+// backupSuffix names the rolling copy of the last successfully parsed data
+// file, kept alongside the real one so a corrupted file can be recovered
+// from instead of bricking the server on boot.
+const backupSuffix = ".bak"
+
+// document is the on-disk shape of the data file.
+type document struct {
+	Expenses            []*model.Expense             `json:"expenses"`
+	Recurring           []*model.RecurringPattern    `json:"recurring"`
+	Channels            []*model.NotificationChannel `json:"channels"`
+	AlertRules          []*model.AlertRule           `json:"alert_rules"`
+	AlertEvents         []*model.AlertEvent          `json:"alert_events"`
+	LinkedAccounts      []*model.LinkedAccount       `json:"linked_accounts"`
+	DraftExpenses       []*model.DraftExpense        `json:"draft_expenses"`
+	Budgets             []*model.Budget              `json:"budgets"`
+	Income              []*model.Income              `json:"income"`
+	Accounts            []*model.Account             `json:"accounts"`
+	Reconciliations     []*model.Reconciliation      `json:"reconciliations"`
+	Debts               []*model.Debt                `json:"debts"`
+	DebtPayments        []*model.DebtPayment         `json:"debt_payments"`
+	Projects            []*model.Project             `json:"projects"`
+	Reminders           []*model.Reminder            `json:"reminders"`
+	Users               []*model.User                `json:"users"`
+	APIKeys             []*model.APIKey              `json:"api_keys"`
+	AuditEvents         []*model.AuditEvent          `json:"audit_events"`
+	Categories          []*model.Category            `json:"categories"`
+	Challenges          []*model.Challenge           `json:"challenges"`
+	SweepLogs           []*model.SweepLog            `json:"sweep_logs"`
+	QueuedNotifications []*model.QueuedNotification  `json:"queued_notifications"`
+	Attachments         []*model.Attachment          `json:"attachments"`
+	PeriodCloseouts     []*model.PeriodCloseout      `json:"period_closeouts"`
+	SyncBlobs           []*model.SyncBlob            `json:"sync_blobs"`
+	Webhooks            []*model.Webhook             `json:"webhooks"`
+	WebhookDeliveries   []*model.WebhookDelivery     `json:"webhook_deliveries"`
+	// PeriodLockedBefore is the closing date set by ClosePeriod: expenses
+	// dated before it are protected from creation, edits, and deletion.
+	// Zero means no period is closed.
+	PeriodLockedBefore time.Time         `json:"period_locked_before,omitempty"`
+	Settings           settings.Settings `json:"settings"`
+}
+
+// maxAlertEvents caps how much alert history is retained, so a busy rule
+// can't grow the data file without bound.
+const maxAlertEvents = 500
+
+// maxAuditEvents caps how much audit history is retained, so a login
+// brute-force attempt can't grow the data file without bound.
+const maxAuditEvents = 2000
+
+// maxSweepLogs caps how much recurring-sweep history is retained, so an
+// hourly scheduler running for years can't grow the data file without
+// bound.
+const maxSweepLogs = 500
+
+// maxQueuedNotifications caps how many notifications can be held back for
+// quiet hours or batching at once, so a misconfigured flush schedule can't
+// grow the data file without bound.
+const maxQueuedNotifications = 1000
+
+// maxWebhookDeliveries caps how much webhook delivery history is retained
+// across all webhooks, so a receiver stuck failing forever can't grow the
+// data file without bound.
+const maxWebhookDeliveries = 2000
+
+// Store holds all expenses and user settings in memory and persists them to
+// a single JSON file on every mutation. It is safe for concurrent use: all
+// read methods (Get, List, Settings, and the per-entity Get*/List* helpers)
+// take mu as a read lock via RLock, so concurrent GETs never block each
+// other; only a mutation or a save() holds the exclusive write lock. Sweeps
+// and other periodic scans (see internal/alerts, internal/notify) run from
+// background schedulers rather than inline on the request path, so they
+// never hold up a foreground read.
+type Store struct {
+	mu                  sync.RWMutex
+	path                string
+	expenses            map[string]*model.Expense
+	recurring           map[string]*model.RecurringPattern
+	channels            map[string]*model.NotificationChannel
+	alertRules          map[string]*model.AlertRule
+	alertEvents         []*model.AlertEvent
+	linkedAccounts      map[string]*model.LinkedAccount
+	drafts              map[string]*model.DraftExpense
+	budgets             map[string]*model.Budget
+	income              map[string]*model.Income
+	accounts            map[string]*model.Account
+	reconciliations     []*model.Reconciliation
+	debts               map[string]*model.Debt
+	debtPayments        []*model.DebtPayment
+	projects            map[string]*model.Project
+	reminders           map[string]*model.Reminder
+	users               map[string]*model.User
+	apiKeys             map[string]*model.APIKey
+	auditEvents         []*model.AuditEvent
+	categories          map[string]*model.Category
+	challenges          map[string]*model.Challenge
+	sweepLogs           []*model.SweepLog
+	queuedNotifications []*model.QueuedNotification
+	attachments         map[string]*model.Attachment
+	// periodCloseouts is keyed by periodCloseoutKey(userID, month) rather
+	// than ID, so closing an already-closed month updates that user's
+	// journal entry instead of appending a duplicate or colliding with
+	// another user's close-out for the same month.
+	periodCloseouts map[string]*model.PeriodCloseout
+	// syncBlobs is keyed by the client-chosen blob ID (see model.SyncBlob),
+	// analogous to categories being keyed by name rather than a
+	// server-generated ID.
+	syncBlobs map[string]*model.SyncBlob
+	webhooks  map[string]*model.Webhook
+	// webhookDeliveries is append-only like auditEvents, but its entries are
+	// mutated in place by a redelivery (see RecordWebhookRedelivery) instead
+	// of only ever being appended to.
+	webhookDeliveries []*model.WebhookDelivery
+	settings          settings.Settings
+	aggregates        aggregates
+	// periodLockedBefore is the closing date set by ClosePeriod. See
+	// document.PeriodLockedBefore.
+	periodLockedBefore time.Time
+	// encryptionKey, when set, is the master key used to derive a per-user
+	// key for encrypting Note/Merchant fields before they're written to
+	// disk. Nil means those fields are stored in plaintext.
+	encryptionKey []byte
+	// revision counts successful persists to disk, and lastPersistedAt is
+	// when the most recent one completed; both are surfaced by the
+	// readiness probe. restoring is set while a backup restore or
+	// migration is in progress, so readiness fails until it clears.
+	revision        int64
+	lastPersistedAt time.Time
+	restoring       bool
+	// pendingBatchWrites is the number of expenses currently being applied
+	// by an in-flight AddBatch or Merge call, i.e. the backlog a bulk
+	// import or bank sync is working through. It's an atomic counter so
+	// PendingBatchWrites can be polled from a metrics goroutine without
+	// taking s.mu.
+	pendingBatchWrites int64
+}
+
+// New loads the store from path, creating an empty store with default
+// settings if the file does not yet exist. encryptionKey, if non-nil,
+// enables field-level encryption of notes and merchant names; pass nil to
+// store them in plaintext.
+func New(path string, encryptionKey []byte) (*Store, error) {
+	s := &Store{
+		path:            path,
+		encryptionKey:   encryptionKey,
+		expenses:        make(map[string]*model.Expense),
+		recurring:       make(map[string]*model.RecurringPattern),
+		channels:        make(map[string]*model.NotificationChannel),
+		alertRules:      make(map[string]*model.AlertRule),
+		linkedAccounts:  make(map[string]*model.LinkedAccount),
+		drafts:          make(map[string]*model.DraftExpense),
+		budgets:         make(map[string]*model.Budget),
+		income:          make(map[string]*model.Income),
+		accounts:        make(map[string]*model.Account),
+		debts:           make(map[string]*model.Debt),
+		projects:        make(map[string]*model.Project),
+		reminders:       make(map[string]*model.Reminder),
+		users:           make(map[string]*model.User),
+		apiKeys:         make(map[string]*model.APIKey),
+		categories:      make(map[string]*model.Category),
+		challenges:      make(map[string]*model.Challenge),
+		attachments:     make(map[string]*model.Attachment),
+		periodCloseouts: make(map[string]*model.PeriodCloseout),
+		syncBlobs:       make(map[string]*model.SyncBlob),
+		webhooks:        make(map[string]*model.Webhook),
+		settings:        settings.Default(),
+		aggregates:      newAggregates(),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	if s.settings.ICSSecret == "" {
+		s.settings.ICSSecret = idgen.New()
+		// No caller-supplied context exists yet during construction.
+		if err := s.save(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *Store) load() error {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read data file: %w", err)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	doc, err := parseDocument(data)
+	if err != nil {
+		return s.recoverFromBackup(err)
+	}
+	s.populate(doc)
+	return nil
+}
+
+// parseDocument decodes the JSON document format shared by the primary data
+// file and its backup.
+func parseDocument(data []byte) (document, error) {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return document{}, fmt.Errorf("parse data file: %w", err)
+	}
+	return doc, nil
+}
+
+// recoverFromBackup runs when the primary data file exists but fails to
+// parse (e.g. the process was killed mid-write despite the atomic rename in
+// save, or the file was corrupted on disk). It quarantines the broken file
+// instead of losing it, then tries the last backup written by a prior
+// successful save. If neither is usable, it returns the original parse
+// error rather than silently starting from an empty store.
+func (s *Store) recoverFromBackup(parseErr error) error {
+	quarantine := s.path + ".corrupt-" + time.Now().UTC().Format("20060102-150405")
+	if err := os.Rename(s.path, quarantine); err != nil {
+		return fmt.Errorf("parse data file: %w (quarantine also failed: %v)", parseErr, err)
+	}
+	backupPath := s.path + backupSuffix
+	data, err := os.ReadFile(backupPath)
+	if err != nil {
+		slog.Error("store: data file corrupt and no backup available", "path", s.path, "quarantined_to", quarantine, "parse_error", parseErr)
+		return fmt.Errorf("parse data file: %w (no backup to recover from)", parseErr)
+	}
+	doc, err := parseDocument(data)
+	if err != nil {
+		slog.Error("store: data file and backup both corrupt", "path", s.path, "quarantined_to", quarantine, "parse_error", parseErr, "backup_error", err)
+		return fmt.Errorf("parse data file: %w (backup also unreadable: %v)", parseErr, err)
+	}
+	s.populate(doc)
+	slog.Warn("store: recovered from backup after corrupt data file",
+		"path", s.path, "backup_path", backupPath, "quarantined_original", quarantine, "parse_error", parseErr)
+	return nil
+}
+
+// populate loads doc's records into the store's in-memory maps and slices.
+func (s *Store) populate(doc document) {
+	for _, e := range doc.Expenses {
+		s.decryptExpenseFields(e)
+		s.expenses[e.ID] = e
+		s.aggregates.add(e)
+	}
+	for _, p := range doc.Recurring {
+		s.decryptRecurringFields(p)
+		s.recurring[p.ID] = p
+	}
+	for _, c := range doc.Channels {
+		s.channels[c.ID] = c
+	}
+	for _, rule := range doc.AlertRules {
+		s.alertRules[rule.ID] = rule
+	}
+	s.alertEvents = doc.AlertEvents
+	for _, a := range doc.LinkedAccounts {
+		s.linkedAccounts[a.ID] = a
+	}
+	for _, d := range doc.DraftExpenses {
+		s.drafts[d.ID] = d
+	}
+	for _, b := range doc.Budgets {
+		s.budgets[b.ID] = b
+	}
+	for _, inc := range doc.Income {
+		s.income[inc.ID] = inc
+	}
+	for _, a := range doc.Accounts {
+		s.accounts[a.ID] = a
+	}
+	s.reconciliations = doc.Reconciliations
+	for _, d := range doc.Debts {
+		s.debts[d.ID] = d
+	}
+	s.debtPayments = doc.DebtPayments
+	for _, p := range doc.Projects {
+		s.projects[p.ID] = p
+	}
+	for _, rem := range doc.Reminders {
+		s.reminders[rem.ID] = rem
+	}
+	for _, u := range doc.Users {
+		s.users[u.ID] = u
+	}
+	for _, k := range doc.APIKeys {
+		s.apiKeys[k.ID] = k
+	}
+	s.auditEvents = doc.AuditEvents
+	for _, c := range doc.Categories {
+		s.categories[c.Name] = c
+	}
+	for _, c := range doc.Challenges {
+		s.challenges[c.ID] = c
+	}
+	s.sweepLogs = doc.SweepLogs
+	s.queuedNotifications = doc.QueuedNotifications
+	for _, a := range doc.Attachments {
+		s.attachments[a.ID] = a
+	}
+	for _, c := range doc.PeriodCloseouts {
+		s.periodCloseouts[periodCloseoutKey(c.UserID, c.Month)] = c
+	}
+	for _, b := range doc.SyncBlobs {
+		s.syncBlobs[b.ID] = b
+	}
+	for _, w := range doc.Webhooks {
+		s.webhooks[w.ID] = w
+	}
+	s.webhookDeliveries = doc.WebhookDeliveries
+	// FiscalMonthStartDay is always 1-28 once settings have ever been saved
+	// (see settings.Default and the PUT validation in handlePutSettings),
+	// so zero means the data file predates the settings key entirely and
+	// New's Default() should stand rather than being overwritten with a
+	// blank struct. Settings can no longer be compared with != directly
+	// now that it holds a map field (CPIIndex).
+	if doc.Settings.FiscalMonthStartDay != 0 {
+		s.settings = doc.Settings
+	}
+	s.periodLockedBefore = doc.PeriodLockedBefore
+}
+
+// save must be called with s.mu held. It builds the full document up front
+// (cheap, in-memory) but checks ctx right before the disk write, the one
+// step slow enough to be worth cancelling.
+func (s *Store) save(ctx context.Context) error {
+	list := make([]*model.Expense, 0, len(s.expenses))
+	for _, e := range s.expenses {
+		enc, err := s.encryptedExpenseCopy(e)
+		if err != nil {
+			return fmt.Errorf("encrypt expense fields: %w", err)
+		}
+		list = append(list, enc)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Date.Before(list[j].Date) })
+	recurring := make([]*model.RecurringPattern, 0, len(s.recurring))
+	for _, p := range s.recurring {
+		enc, err := s.encryptedRecurringCopy(p)
+		if err != nil {
+			return fmt.Errorf("encrypt recurring pattern fields: %w", err)
+		}
+		recurring = append(recurring, enc)
+	}
+	sort.Slice(recurring, func(i, j int) bool { return recurring[i].CreatedAt.Before(recurring[j].CreatedAt) })
+	channels := make([]*model.NotificationChannel, 0, len(s.channels))
+	for _, c := range s.channels {
+		channels = append(channels, c)
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].CreatedAt.Before(channels[j].CreatedAt) })
+	rules := make([]*model.AlertRule, 0, len(s.alertRules))
+	for _, rule := range s.alertRules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].CreatedAt.Before(rules[j].CreatedAt) })
+	linkedAccounts := make([]*model.LinkedAccount, 0, len(s.linkedAccounts))
+	for _, a := range s.linkedAccounts {
+		linkedAccounts = append(linkedAccounts, a)
+	}
+	sort.Slice(linkedAccounts, func(i, j int) bool { return linkedAccounts[i].CreatedAt.Before(linkedAccounts[j].CreatedAt) })
+	drafts := make([]*model.DraftExpense, 0, len(s.drafts))
+	for _, d := range s.drafts {
+		drafts = append(drafts, d)
+	}
+	sort.Slice(drafts, func(i, j int) bool { return drafts[i].CreatedAt.Before(drafts[j].CreatedAt) })
+	budgets := make([]*model.Budget, 0, len(s.budgets))
+	for _, b := range s.budgets {
+		budgets = append(budgets, b)
+	}
+	sort.Slice(budgets, func(i, j int) bool { return budgets[i].CreatedAt.Before(budgets[j].CreatedAt) })
+	income := make([]*model.Income, 0, len(s.income))
+	for _, inc := range s.income {
+		income = append(income, inc)
+	}
+	sort.Slice(income, func(i, j int) bool { return income[i].Date.Before(income[j].Date) })
+	accounts := make([]*model.Account, 0, len(s.accounts))
+	for _, a := range s.accounts {
+		accounts = append(accounts, a)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].CreatedAt.Before(accounts[j].CreatedAt) })
+	debts := make([]*model.Debt, 0, len(s.debts))
+	for _, d := range s.debts {
+		debts = append(debts, d)
+	}
+	sort.Slice(debts, func(i, j int) bool { return debts[i].CreatedAt.Before(debts[j].CreatedAt) })
+	projects := make([]*model.Project, 0, len(s.projects))
+	for _, p := range s.projects {
+		projects = append(projects, p)
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].CreatedAt.Before(projects[j].CreatedAt) })
+	reminders := make([]*model.Reminder, 0, len(s.reminders))
+	for _, rem := range s.reminders {
+		reminders = append(reminders, rem)
+	}
+	sort.Slice(reminders, func(i, j int) bool { return reminders[i].DueDate.Before(reminders[j].DueDate) })
+	users := make([]*model.User, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].CreatedAt.Before(users[j].CreatedAt) })
+	apiKeys := make([]*model.APIKey, 0, len(s.apiKeys))
+	for _, k := range s.apiKeys {
+		apiKeys = append(apiKeys, k)
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].CreatedAt.Before(apiKeys[j].CreatedAt) })
+	categories := make([]*model.Category, 0, len(s.categories))
+	for _, c := range s.categories {
+		categories = append(categories, c)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].Name < categories[j].Name })
+	challenges := make([]*model.Challenge, 0, len(s.challenges))
+	for _, c := range s.challenges {
+		challenges = append(challenges, c)
+	}
+	sort.Slice(challenges, func(i, j int) bool { return challenges[i].CreatedAt.Before(challenges[j].CreatedAt) })
+	attachmentsList := make([]*model.Attachment, 0, len(s.attachments))
+	for _, a := range s.attachments {
+		attachmentsList = append(attachmentsList, a)
+	}
+	sort.Slice(attachmentsList, func(i, j int) bool { return attachmentsList[i].CreatedAt.Before(attachmentsList[j].CreatedAt) })
+	periodCloseouts := make([]*model.PeriodCloseout, 0, len(s.periodCloseouts))
+	for _, c := range s.periodCloseouts {
+		periodCloseouts = append(periodCloseouts, c)
+	}
+	sort.Slice(periodCloseouts, func(i, j int) bool { return periodCloseouts[i].Month < periodCloseouts[j].Month })
+	syncBlobs := make([]*model.SyncBlob, 0, len(s.syncBlobs))
+	for _, b := range s.syncBlobs {
+		syncBlobs = append(syncBlobs, b)
+	}
+	sort.Slice(syncBlobs, func(i, j int) bool { return syncBlobs[i].ID < syncBlobs[j].ID })
+	webhooks := make([]*model.Webhook, 0, len(s.webhooks))
+	for _, w := range s.webhooks {
+		webhooks = append(webhooks, w)
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt) })
+	doc := document{
+		Expenses:            list,
+		Recurring:           recurring,
+		Channels:            channels,
+		AlertRules:          rules,
+		AlertEvents:         s.alertEvents,
+		LinkedAccounts:      linkedAccounts,
+		DraftExpenses:       drafts,
+		Budgets:             budgets,
+		Income:              income,
+		Accounts:            accounts,
+		Reconciliations:     s.reconciliations,
+		Debts:               debts,
+		DebtPayments:        s.debtPayments,
+		Projects:            projects,
+		Reminders:           reminders,
+		Users:               users,
+		APIKeys:             apiKeys,
+		AuditEvents:         s.auditEvents,
+		Categories:          categories,
+		Challenges:          challenges,
+		SweepLogs:           s.sweepLogs,
+		QueuedNotifications: s.queuedNotifications,
+		Attachments:         attachmentsList,
+		PeriodCloseouts:     periodCloseouts,
+		SyncBlobs:           syncBlobs,
+		Webhooks:            webhooks,
+		WebhookDeliveries:   s.webhookDeliveries,
+		PeriodLockedBefore:  s.periodLockedBefore,
+		Settings:            s.settings,
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode data file: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write data file: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+	// Refresh the backup from the copy we just successfully wrote and
+	// parsed, so a future corrupt primary file has something recent to
+	// recover from. This is best-effort: losing the backup doesn't lose
+	// data, so a failure here is logged rather than returned.
+	if err := os.WriteFile(s.path+backupSuffix, data, 0o644); err != nil {
+		slog.Warn("store: failed to refresh backup file", "path", s.path, "error", err)
+	}
+	s.revision++
+	s.lastPersistedAt = time.Now()
+	return nil
+}
+
+// Settings returns the current user settings.
+func (s *Store) Settings() settings.Settings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.settings
+}
+
+// Snapshot is a single, internally consistent read of the data a heavy
+// dashboard query needs, in one RLock instead of one per entity. Calling
+// List, ListBudgets, ListRecurring, and ListReminders separately can each
+// interleave with a different concurrent write, so a page built from four
+// of those calls can end up mixing data from before and after the same
+// write; Snapshot never can.
+type Snapshot struct {
+	Expenses  []*model.Expense
+	Budgets   []*model.Budget
+	Recurring []*model.RecurringPattern
+	Reminders []*model.Reminder
+	Settings  settings.Settings
+	// Revision matches Store.Revision() as of this snapshot, so a caller
+	// polling for freshness doesn't need a second call to check it.
+	Revision int64
+}
+
+// Snapshot returns the data behind Snapshot in one lock acquisition,
+// active-only for budgets and recurring patterns and unnotified-only for
+// reminders, matching ListBudgets(false)/ListRecurring(false)/
+// ListReminders(false).
+func (s *Store) Snapshot() Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	expenses := make([]*model.Expense, 0, len(s.expenses))
+	for _, e := range s.expenses {
+		expenses = append(expenses, e)
+	}
+	sort.Slice(expenses, func(i, j int) bool { return expenses[i].Date.Before(expenses[j].Date) })
+	budgets := make([]*model.Budget, 0, len(s.budgets))
+	for _, b := range s.budgets {
+		if b.Active {
+			budgets = append(budgets, b)
+		}
+	}
+	sort.Slice(budgets, func(i, j int) bool { return budgets[i].CreatedAt.Before(budgets[j].CreatedAt) })
+	recurring := make([]*model.RecurringPattern, 0, len(s.recurring))
+	for _, p := range s.recurring {
+		if p.Active {
+			recurring = append(recurring, p)
+		}
+	}
+	sort.Slice(recurring, func(i, j int) bool { return recurring[i].CreatedAt.Before(recurring[j].CreatedAt) })
+	reminders := make([]*model.Reminder, 0, len(s.reminders))
+	for _, rem := range s.reminders {
+		if !rem.Notified {
+			reminders = append(reminders, rem)
+		}
+	}
+	sort.Slice(reminders, func(i, j int) bool { return reminders[i].DueDate.Before(reminders[j].DueDate) })
+	return Snapshot{
+		Expenses:  expenses,
+		Budgets:   budgets,
+		Recurring: recurring,
+		Reminders: reminders,
+		Settings:  s.settings,
+		Revision:  s.revision,
+	}
+}
+
+// UpdateSettings replaces the user settings and persists them.
+func (s *Store) UpdateSettings(ctx context.Context, v settings.Settings) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settings = v
+	return s.save(ctx)
+}
+
+// Add inserts e, overwriting any existing expense with the same ID.
+// Reconciled expenses are protected from being silently replaced.
+// Fingerprint is (re)computed here so every path that saves an expense —
+// manual entry, CSV/QIF/YNAB/Mint import, bank sync — gets one without
+// having to remember to set it itself.
+func (s *Store) Add(ctx context.Context, e *model.Expense) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	e.Fingerprint = importer.Fingerprint(e.Date, e.Amount, e.Merchant)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.expenses[e.ID]; ok {
+		if old.Reconciled {
+			return fmt.Errorf("expense %s is reconciled and cannot be modified", e.ID)
+		}
+		if err := s.checkPeriodUnlocked(old.Date.Time()); err != nil {
+			return err
+		}
+		if e.CreatedAt.IsZero() {
+			e.CreatedAt = old.CreatedAt
+		}
+		s.aggregates.remove(old)
+	}
+	if err := s.checkPeriodUnlocked(e.Date.Time()); err != nil {
+		return err
+	}
+	e.UpdatedAt = time.Now()
+	s.expenses[e.ID] = e
+	s.aggregates.add(e)
+	s.ensureCategory(e.Category)
+	return s.save(ctx)
+}
+
+// AddBatchResult summarizes what AddBatch did with each expense it was
+// given, by the expense's index in the slice passed to AddBatch.
+type AddBatchResult struct {
+	// Added is how many expenses were applied.
+	Added int
+	// Skipped maps the index of an expense that failed its per-item checks
+	// (a locked period, or colliding with a reconciled expense) to why,
+	// mirroring Merge's per-item skip behavior rather than aborting the
+	// whole batch over one bad row.
+	Skipped map[int]error
+}
+
+// AddBatch is Add applied to many expenses under a single lock and a
+// single disk write, for bulk paths (CSV/QIF/preset import, bank sync)
+// that would otherwise trigger one full-file rewrite per expense — the
+// "one write per request" cost a burst of individual Add calls pays.
+func (s *Store) AddBatch(ctx context.Context, expenses []*model.Expense) (AddBatchResult, error) {
+	var result AddBatchResult
+	if len(expenses) == 0 {
+		return result, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	atomic.AddInt64(&s.pendingBatchWrites, int64(len(expenses)))
+	defer atomic.AddInt64(&s.pendingBatchWrites, -int64(len(expenses)))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	skip := func(i int, err error) {
+		if result.Skipped == nil {
+			result.Skipped = make(map[int]error)
+		}
+		result.Skipped[i] = err
+	}
+	for i, e := range expenses {
+		e.Fingerprint = importer.Fingerprint(e.Date, e.Amount, e.Merchant)
+		if old, ok := s.expenses[e.ID]; ok {
+			if old.Reconciled {
+				skip(i, fmt.Errorf("expense %s is reconciled and cannot be modified", e.ID))
+				continue
+			}
+			if err := s.checkPeriodUnlocked(old.Date.Time()); err != nil {
+				skip(i, err)
+				continue
+			}
+			if e.CreatedAt.IsZero() {
+				e.CreatedAt = old.CreatedAt
+			}
+			s.aggregates.remove(old)
+		}
+		if err := s.checkPeriodUnlocked(e.Date.Time()); err != nil {
+			skip(i, err)
+			continue
+		}
+		e.UpdatedAt = time.Now()
+		s.expenses[e.ID] = e
+		s.aggregates.add(e)
+		s.ensureCategory(e.Category)
+		result.Added++
+	}
+	if result.Added == 0 {
+		return result, nil
+	}
+	return result, s.save(ctx)
+}
+
+// Get returns the expense with the given ID, or false if none exists.
+func (s *Store) Get(id string) (*model.Expense, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.expenses[id]
+	return e, ok
+}
+
+// Delete removes the expense with the given ID, if present.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.expenses[id]
+	if !ok {
+		return nil
+	}
+	if err := s.checkPeriodUnlocked(old.Date.Time()); err != nil {
+		return err
+	}
+	s.aggregates.remove(old)
+	delete(s.expenses, id)
+	return s.save(ctx)
+}
+
+// PurgeExpenses removes the expenses with the given IDs, for
+// internal/retention's age-based expense purge. IDs that don't exist, or
+// that fall in a locked period (see ClosePeriod), are silently skipped
+// rather than erroring the whole call, since a record already protected
+// from deletion by a period lock must stay protected from an automated
+// purge too.
+func (s *Store) PurgeExpenses(ctx context.Context, ids []string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	removed := 0
+	for _, id := range ids {
+		e, ok := s.expenses[id]
+		if !ok {
+			continue
+		}
+		if s.checkPeriodUnlocked(e.Date.Time()) != nil {
+			continue
+		}
+		s.aggregates.remove(e)
+		delete(s.expenses, id)
+		removed++
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, s.save(ctx)
+}
+
+// List returns all expenses ordered by date ascending.
+func (s *Store) List() []*model.Expense {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.Expense, 0, len(s.expenses))
+	for _, e := range s.expenses {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Date.Before(list[j].Date) })
+	return list
+}
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// RecordSweepLog appends log to the recurring-sweep history, evicting the
+// oldest entries beyond maxSweepLogs.
+func (s *Store) RecordSweepLog(ctx context.Context, log *model.SweepLog) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweepLogs = append(s.sweepLogs, log)
+	if len(s.sweepLogs) > maxSweepLogs {
+		s.sweepLogs = s.sweepLogs[len(s.sweepLogs)-maxSweepLogs:]
+	}
+	return s.save(ctx)
+}
+
+// ListSweepLogs returns sweep history, most recent first.
+func (s *Store) ListSweepLogs() []*model.SweepLog {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.SweepLog, len(s.sweepLogs))
+	for i, l := range s.sweepLogs {
+		list[len(list)-1-i] = l
+	}
+	return list
+}
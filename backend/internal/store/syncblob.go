@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// PutSyncBlob inserts or updates the blob at (userID, id). If expectedRevision
+// is non-zero, the write is rejected unless it matches the blob's current
+// Revision, so a client that hasn't seen another device's latest write
+// doesn't silently clobber it; pass 0 to skip the check (e.g. the blob's
+// first write).
+func (s *Store) PutSyncBlob(ctx context.Context, userID, id string, ciphertext, nonce []byte, expectedRevision int64) (*model.SyncBlob, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	existing, ok := s.syncBlobs[id]
+	if ok {
+		if existing.UserID != userID {
+			return nil, fmt.Errorf("sync blob %s belongs to another user", id)
+		}
+		if expectedRevision != 0 && existing.Revision != expectedRevision {
+			return nil, fmt.Errorf("sync blob %s revision conflict: expected %d, have %d", id, expectedRevision, existing.Revision)
+		}
+	} else if expectedRevision != 0 {
+		return nil, fmt.Errorf("sync blob %s revision conflict: expected %d, have none", id, expectedRevision)
+	}
+	b := &model.SyncBlob{
+		ID:         id,
+		UserID:     userID,
+		Ciphertext: ciphertext,
+		Nonce:      nonce,
+		UpdatedAt:  now,
+	}
+	if ok {
+		b.Revision = existing.Revision + 1
+		b.CreatedAt = existing.CreatedAt
+	} else {
+		b.Revision = 1
+		b.CreatedAt = now
+	}
+	s.syncBlobs[id] = b
+	if err := s.save(ctx); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// GetSyncBlob returns userID's blob with the given ID, or false if none
+// exists or it belongs to a different user.
+func (s *Store) GetSyncBlob(userID, id string) (*model.SyncBlob, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.syncBlobs[id]
+	if !ok || b.UserID != userID {
+		return nil, false
+	}
+	return b, true
+}
+
+// ListSyncBlobs returns userID's blobs ordered by ID, for a client to diff
+// its local (id, revision) pairs against without downloading every
+// ciphertext.
+func (s *Store) ListSyncBlobs(userID string) []*model.SyncBlob {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]*model.SyncBlob, 0, len(s.syncBlobs))
+	for _, b := range s.syncBlobs {
+		if b.UserID == userID {
+			list = append(list, b)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// DeleteSyncBlob removes userID's blob with the given ID, if present.
+func (s *Store) DeleteSyncBlob(ctx context.Context, userID, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.syncBlobs[id]
+	if !ok || b.UserID != userID {
+		return nil
+	}
+	delete(s.syncBlobs, id)
+	return s.save(ctx)
+}
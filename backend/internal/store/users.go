@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddUser inserts u, overwriting any existing user with the same ID. It
+// rejects an email already used by a different user.
+func (s *Store) AddUser(ctx context.Context, u *model.User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.users {
+		if existing.Email == u.Email && existing.ID != u.ID {
+			return fmt.Errorf("email %s is already registered", u.Email)
+		}
+	}
+	s.users[u.ID] = u
+	return s.save(ctx)
+}
+
+// GetUser returns the user with the given ID, or false if none exists.
+func (s *Store) GetUser(id string) (*model.User, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.users[id]
+	return u, ok
+}
+
+// GetUserByEmail returns the user registered with the given email, or false
+// if none exists.
+func (s *Store) GetUserByEmail(email string) (*model.User, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, u := range s.users {
+		if u.Email == email {
+			return u, true
+		}
+	}
+	return nil, false
+}
+
+// EraseUser irreversibly deletes userID's account and everything scoped to
+// it: expenses, recurring patterns, API keys, and audit history recorded
+// under their email. There's no undo, per the confirmation flow required
+// before this is called.
+func (s *Store) EraseUser(ctx context.Context, userID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[userID]
+	if !ok {
+		return nil
+	}
+	for id, e := range s.expenses {
+		if e.UserID == userID {
+			s.aggregates.remove(e)
+			delete(s.expenses, id)
+		}
+	}
+	for id, p := range s.recurring {
+		if p.UserID == userID {
+			delete(s.recurring, id)
+		}
+	}
+	for id, k := range s.apiKeys {
+		if k.UserID == userID {
+			delete(s.apiKeys, id)
+		}
+	}
+	filtered := s.auditEvents[:0:0]
+	for _, ev := range s.auditEvents {
+		if ev.Email != u.Email {
+			filtered = append(filtered, ev)
+		}
+	}
+	s.auditEvents = filtered
+	delete(s.users, userID)
+	return s.save(ctx)
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+// AddWebhook inserts w, overwriting any existing webhook with the same ID.
+func (s *Store) AddWebhook(ctx context.Context, w *model.Webhook) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.webhooks[w.ID] = w
+	return s.save(ctx)
+}
+
+// GetWebhook returns the webhook with the given ID, or false if none
+// exists.
+func (s *Store) GetWebhook(id string) (*model.Webhook, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	w, ok := s.webhooks[id]
+	return w, ok
+}
+
+// ListWebhooks returns userID's registered webhooks, ordered by creation
+// time.
+func (s *Store) ListWebhooks(userID string) []*model.Webhook {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var list []*model.Webhook
+	for _, w := range s.webhooks {
+		if w.UserID == userID {
+			list = append(list, w)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list
+}
+
+// DeleteWebhook removes the webhook with the given ID. Its delivery history
+// is left in place, matching DeleteRecurring's treatment of history when a
+// pattern is purged.
+func (s *Store) DeleteWebhook(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.webhooks, id)
+	return s.save(ctx)
+}
+
+// RecordWebhookDelivery appends delivery to the delivery log, evicting the
+// oldest entries beyond maxWebhookDeliveries.
+func (s *Store) RecordWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.webhookDeliveries = append(s.webhookDeliveries, delivery)
+	if len(s.webhookDeliveries) > maxWebhookDeliveries {
+		s.webhookDeliveries = s.webhookDeliveries[len(s.webhookDeliveries)-maxWebhookDeliveries:]
+	}
+	return s.save(ctx)
+}
+
+// ListWebhookDeliveries returns webhookID's delivery log, most recent
+// first.
+func (s *Store) ListWebhookDeliveries(webhookID string) []*model.WebhookDelivery {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var list []*model.WebhookDelivery
+	for i := len(s.webhookDeliveries) - 1; i >= 0; i-- {
+		if d := s.webhookDeliveries[i]; d.WebhookID == webhookID {
+			list = append(list, d)
+		}
+	}
+	return list
+}
+
+// GetWebhookDelivery returns the delivery with the given ID under
+// webhookID, or false if none exists.
+func (s *Store) GetWebhookDelivery(webhookID, deliveryID string) (*model.WebhookDelivery, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, d := range s.webhookDeliveries {
+		if d.WebhookID == webhookID && d.ID == deliveryID {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// RecordWebhookRedelivery increments the attempt count on the delivery
+// identified by webhookID/deliveryID and updates its outcome, for a
+// redelivery of an existing payload rather than a newly generated event.
+// The delivery's ID and original Payload are left untouched, so a receiver
+// resending the same delivery ID can still detect it as the same logical
+// event.
+func (s *Store) RecordWebhookRedelivery(ctx context.Context, webhookID, deliveryID string, status model.WebhookDeliveryStatus, responseCode int, deliveryErr string, deliveredAt time.Time) (*model.WebhookDelivery, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, d := range s.webhookDeliveries {
+		if d.WebhookID == webhookID && d.ID == deliveryID {
+			d.Attempts++
+			d.Status = status
+			d.ResponseCode = responseCode
+			d.Error = deliveryErr
+			d.DeliveredAt = deliveredAt
+			if err := s.save(ctx); err != nil {
+				return nil, err
+			}
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("delivery %s not found", deliveryID)
+}
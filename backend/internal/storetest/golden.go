@@ -0,0 +1,33 @@
+package storetest
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+// update rewrites golden files with -update instead of failing the test
+// they belong to, the standard Go convention for maintaining golden files:
+// go test ./... -run TestName -update
+var update = flag.Bool("update", false, "rewrite golden files with actual output")
+
+// AssertGolden compares got against the contents of the golden file at
+// path, failing t with a diff-friendly message if they differ. Run with
+// -update to write got as the new golden contents instead of comparing,
+// e.g. after an intentional output change.
+func AssertGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write golden file %s: %v", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file %s: %v (run with -update to create it)", path, err)
+	}
+	if string(got) != string(want) {
+		t.Fatalf("output does not match golden file %s\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
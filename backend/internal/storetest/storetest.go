@@ -0,0 +1,65 @@
+// Package storetest provides shared fixtures for tests that need a
+// *store.Store, a model.Expense, or a model.RecurringPattern without every
+// package reinventing the same boilerplate. New backs the store with a
+// throwaway t.TempDir() directory instead of a real shared file, so tests
+// never leak state into each other or the working tree.
+package storetest
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/civildate"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/money"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// New returns a *store.Store backed by a fresh, empty data file inside a
+// t.TempDir() directory, which testing removes automatically once t (and
+// any subtests sharing it) finish.
+func New(t *testing.T) *store.Store {
+	t.Helper()
+	st, err := store.New(filepath.Join(t.TempDir(), "data.json"), nil)
+	if err != nil {
+		t.Fatalf("storetest.New: %v", err)
+	}
+	return st
+}
+
+// NewExpense builds a model.Expense fixture with sensible defaults
+// (today's date, a non-zero amount, the "Groceries" category), applying
+// opts in order so a test only needs to set the fields it actually cares
+// about, e.g. NewExpense(func(e *model.Expense) { e.Amount = 500 }).
+func NewExpense(opts ...func(*model.Expense)) *model.Expense {
+	e := &model.Expense{
+		Amount:    money.FromFloat(9.99),
+		Category:  "Groceries",
+		Date:      civildate.Today(),
+		CreatedAt: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// NewRecurringPattern builds a model.RecurringPattern fixture with
+// sensible defaults (active, monthly, starting today), applying opts in
+// order so a test only needs to set the fields it actually cares about.
+func NewRecurringPattern(opts ...func(*model.RecurringPattern)) *model.RecurringPattern {
+	p := &model.RecurringPattern{
+		Amount:    money.FromFloat(9.99),
+		Category:  "Groceries",
+		Frequency: model.FrequencyMonthly,
+		StartDate: time.Now(),
+		Active:    true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
@@ -0,0 +1,39 @@
+package storetest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"halclarke97/budgetapp/backend/internal/model"
+)
+
+func TestNewReturnsAnEmptyUsableStore(t *testing.T) {
+	st := New(t)
+	if got := len(st.List()); got != 0 {
+		t.Fatalf("got %d expenses, want 0", got)
+	}
+	e := NewExpense()
+	if err := st.Add(context.Background(), e); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if got := len(st.List()); got != 1 {
+		t.Fatalf("got %d expenses, want 1", got)
+	}
+}
+
+func TestNewExpenseAppliesOptsOverDefaults(t *testing.T) {
+	e := NewExpense(func(e *model.Expense) {})
+	if e.Category != "Groceries" {
+		t.Fatalf("got category %q, want default \"Groceries\"", e.Category)
+	}
+}
+
+func TestAssertGoldenComparesFileContents(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/golden.txt"
+	if err := os.WriteFile(path, []byte("hello"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	AssertGolden(t, path, []byte("hello"))
+}
@@ -0,0 +1,38 @@
+// Package systemd implements the minimal subset of systemd's socket
+// activation protocol (sd_listen_fds(3)) needed to accept a listener systemd
+// opened on our behalf, without pulling in the full coreos/go-systemd
+// library.
+package systemd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+)
+
+// listenFDsStart is the first file descriptor systemd passes to an
+// activated process, per the protocol; descriptors 0-2 are stdio.
+const listenFDsStart = 3
+
+// Listener returns the listener systemd handed to this process via socket
+// activation, if any. ok is false when the process wasn't started that way
+// (LISTEN_PID doesn't match, or the vars are unset), so the caller should
+// fall back to opening its own listener.
+func Listener() (l net.Listener, ok bool, err error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, false, nil
+	}
+	count, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || count < 1 {
+		return nil, false, nil
+	}
+	// budgetapp only ever asks systemd for a single socket unit.
+	file := os.NewFile(uintptr(listenFDsStart), "systemd-activation")
+	l, err = net.FileListener(file)
+	if err != nil {
+		return nil, false, fmt.Errorf("systemd socket activation: %w", err)
+	}
+	return l, true, nil
+}
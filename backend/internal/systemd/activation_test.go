@@ -0,0 +1,27 @@
+package systemd
+
+import "testing"
+
+func TestListenerNotActivatedWithoutEnv(t *testing.T) {
+	t.Setenv("LISTEN_PID", "")
+	t.Setenv("LISTEN_FDS", "")
+	_, ok, err := Listener()
+	if err != nil {
+		t.Fatalf("Listener: %v", err)
+	}
+	if ok {
+		t.Fatal("expected ok=false when LISTEN_PID/LISTEN_FDS are unset")
+	}
+}
+
+func TestListenerNotActivatedForOtherProcess(t *testing.T) {
+	t.Setenv("LISTEN_PID", "1")
+	t.Setenv("LISTEN_FDS", "1")
+	_, ok, err := Listener()
+	if err != nil {
+		t.Fatalf("Listener: %v", err)
+	}
+	if ok {
+		t.Fatal("expected ok=false when LISTEN_PID doesn't match this process")
+	}
+}
@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+)
+
+// ValidateURL checks that rawURL is safe for the server to make outbound
+// requests to on a caller's behalf (see Deliver): only http/https schemes,
+// with a host that isn't loopback, link-local, or otherwise private,
+// whether given directly as an IP literal or resolved via DNS. Without
+// this, any authenticated caller could register a webhook pointing at
+// internal infrastructure (e.g. a cloud metadata endpoint) and have the
+// server fetch or POST to it on their behalf (SSRF).
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url must use http or https")
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("url must include a host")
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return fmt.Errorf("could not resolve host: %w", err)
+	}
+	if len(ips) == 0 {
+		return fmt.Errorf("could not resolve host")
+	}
+	for _, ip := range ips {
+		if isBlockedWebhookAddress(ip) {
+			return fmt.Errorf("url resolves to a disallowed address")
+		}
+	}
+	return nil
+}
+
+// isBlockedWebhookAddress reports whether ip is inside a range a webhook
+// target must never resolve to: loopback, link-local (including the cloud
+// metadata address ranges), other RFC 1918/4193 private space, or
+// unspecified.
+func isBlockedWebhookAddress(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsMulticast()
+}
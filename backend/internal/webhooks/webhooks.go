@@ -0,0 +1,165 @@
+// Package webhooks delivers signed event payloads to caller-registered HTTP
+// endpoints (see internal/model.Webhook), following the timestamp,
+// signature, and delivery-ID conventions production webhook providers use
+// so a receiver can verify authenticity and reject replays.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"halclarke97/budgetapp/backend/internal/idgen"
+	"halclarke97/budgetapp/backend/internal/model"
+	"halclarke97/budgetapp/backend/internal/store"
+)
+
+// httpClient is a package-level var so tests can swap in a fake transport.
+//
+// ValidateURL only guards the target_url a caller registers a webhook
+// with; it resolves DNS once at that moment and is never consulted again.
+// A hostname that resolves to a public IP at registration time can
+// legitimately resolve to something else (an internal address, e.g.
+// 169.254.169.254) by the time a delivery actually goes out (DNS
+// rebinding), and a receiver's 3xx response could just as easily redirect
+// the request straight at an internal address regardless of where the
+// hostname resolves. DialContext closes the first gap by re-validating
+// and pinning to a specific IP at the moment each connection (including
+// one to a redirect target) is actually dialed, and CheckRedirect closes
+// the second by refusing to follow redirects at all rather than trying to
+// safely re-validate an arbitrary chain of them.
+var httpClient = &http.Client{
+	Timeout: 10 * time.Second,
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+	Transport: &http.Transport{
+		DialContext: dialValidated,
+	},
+}
+
+// dialValidated resolves addr's host, rejects it if any resolved IP is
+// blocked (see isBlockedWebhookAddress), and then dials that exact IP
+// rather than handing the hostname back to net.Dialer, which would
+// re-resolve it a second time and could observe a different (rebound)
+// answer than the one just validated.
+func dialValidated(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip", host)
+	if err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("could not resolve host")
+	}
+	for _, ip := range ips {
+		if isBlockedWebhookAddress(ip) {
+			return nil, fmt.Errorf("url resolves to a disallowed address")
+		}
+	}
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].String(), port))
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 signature over
+// "<unix timestamp>.<payload>", so a receiver recomputes it from the
+// X-Webhook-Timestamp header and the raw body to verify both authenticity
+// and that the timestamp wasn't altered after signing.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Deliver POSTs payload to hook's URL with the delivery's ID, timestamp,
+// and signature as headers, returning the response status code. An error
+// means the request itself couldn't be completed (e.g. DNS failure,
+// connection refused); a non-2xx status code is returned, not an error, so
+// the caller can record it on the delivery.
+func Deliver(hook *model.Webhook, deliveryID string, timestamp time.Time, payload []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, hook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", deliveryID)
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set("X-Webhook-Signature", Sign(hook.Secret, timestamp, payload))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// DispatchEvent delivers data (marshaled to JSON) to every active webhook
+// userID has registered for event, recording one WebhookDelivery per
+// recipient regardless of outcome, and continuing past individual delivery
+// failures. It's meant to be called with `go` from the request path, the
+// same way internal/alerts.CheckOnCreate is, so a slow or unreachable
+// receiver never delays the response to the caller who triggered the
+// event.
+func DispatchEvent(ctx context.Context, st *store.Store, event model.WebhookEvent, userID string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+	var firstErr error
+	for _, hook := range st.ListWebhooks(userID) {
+		if !hook.Active {
+			continue
+		}
+		subscribed := false
+		for _, e := range hook.Events {
+			if e == event {
+				subscribed = true
+				break
+			}
+		}
+		if !subscribed {
+			continue
+		}
+		now := time.Now()
+		delivery := &model.WebhookDelivery{
+			ID:        idgen.New(),
+			WebhookID: hook.ID,
+			Event:     event,
+			Payload:   payload,
+			Timestamp: now,
+			Status:    model.WebhookDeliveryPending,
+			Attempts:  1,
+			CreatedAt: now,
+		}
+		code, sendErr := Deliver(hook, delivery.ID, now, payload)
+		delivery.ResponseCode = code
+		delivery.DeliveredAt = time.Now()
+		switch {
+		case sendErr != nil:
+			delivery.Status = model.WebhookDeliveryFailed
+			delivery.Error = sendErr.Error()
+		case code >= 200 && code < 300:
+			delivery.Status = model.WebhookDeliverySucceeded
+		default:
+			delivery.Status = model.WebhookDeliveryFailed
+			delivery.Error = fmt.Sprintf("receiver returned status %d", code)
+		}
+		if err := st.RecordWebhookDelivery(ctx, delivery); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,27 @@
+// Package webui embeds the built frontend so it ships inside the backend
+// binary instead of needing a separate static file server in front of it.
+//
+// dist/ holds whatever the frontend build produces (index.html plus hashed
+// asset files); this checkout only carries a placeholder until the frontend
+// build is wired into the release process.
+package webui
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed dist
+var embedded embed.FS
+
+// FS is the embedded asset tree, rooted at dist/ so callers see
+// "index.html" rather than "dist/index.html".
+var FS = mustSub(embedded, "dist")
+
+func mustSub(f embed.FS, dir string) fs.FS {
+	sub, err := fs.Sub(f, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}